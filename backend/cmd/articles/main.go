@@ -0,0 +1,187 @@
+// Command articles imports and exports blog articles as Markdown files with
+// YAML front matter.
+//
+//	go run ./cmd/articles import [-author ID] <dir|archive.zip|file.md>...
+//	go run ./cmd/articles export <dir>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/infrastructure/config"
+	"portfolio/internal/infrastructure/db"
+	"portfolio/internal/repository"
+	"portfolio/internal/usecase"
+)
+
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	cfg := config.New()
+	database, err := db.NewPostgresConnection(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.Close()
+
+	articleRepo := repository.NewArticlePostgresRepository(database)
+	categoryRepo := repository.NewCategoryPostgresRepository(database)
+	articleRevisionRepo := repository.NewArticleRevisionPostgresRepository(database)
+	articleRelationRepo := repository.NewArticleRelationPostgresRepository(database)
+	seriesRepo := repository.NewSeriesPostgresRepository(database)
+	slugRedirectRepo := repository.NewSlugRedirectPostgresRepository(database)
+	articleWorkflowRepo := repository.NewArticleWorkflowPostgresRepository(database)
+	articleTranslationRepo := repository.NewArticleTranslationPostgresRepository(database)
+
+	articleUseCase := usecase.NewArticleUsecase(articleRepo, categoryRepo, articleRevisionRepo, articleRelationRepo, seriesRepo, slugRedirectRepo, articleWorkflowRepo, articleTranslationRepo, domain.NewContentLinter(), database.DB, 10*time.Second)
+	markdownUseCase := usecase.NewArticleMarkdownUsecase(articleUseCase, categoryRepo, 10*time.Second)
+
+	ctx := context.Background()
+	switch os.Args[1] {
+	case "import":
+		os.Exit(runImport(ctx, markdownUseCase, os.Args[2:]))
+	case "export":
+		os.Exit(runExport(ctx, markdownUseCase, os.Args[2:]))
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:")
+	fmt.Fprintln(os.Stderr, "  articles import [-author ID] <dir|archive.zip|file.md>...")
+	fmt.Fprintln(os.Stderr, "  articles export <dir>")
+	os.Exit(2)
+}
+
+func runImport(ctx context.Context, markdownUseCase domain.ArticleMarkdownUsecase, args []string) int {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	authorID := flags.String("author", "", "author user ID (defaults to the first admin)")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		usage()
+	}
+
+	var files []domain.MarkdownFile
+	for _, source := range flags.Args() {
+		found, err := readMarkdownSource(source)
+		if err != nil {
+			log.Printf("%s: %v", source, err)
+			return 1
+		}
+		files = append(files, found...)
+	}
+	if len(files) == 0 {
+		log.Println("No Markdown files found")
+		return 1
+	}
+
+	// The command runs with database access, so it may publish like an admin
+	results, err := markdownUseCase.ImportMarkdown(ctx, files, domain.Actor{ID: *authorID, Role: domain.RoleAdmin})
+	if err != nil {
+		log.Printf("Import failed: %v", err)
+		return 1
+	}
+
+	failed := 0
+	for _, result := range results {
+		switch result.Status {
+		case domain.ImportStatusCreated:
+			fmt.Printf("created  %s -> %s\n", result.File, result.Slug)
+		case domain.ImportStatusSkipped:
+			fmt.Printf("skipped  %s (%s)\n", result.File, result.Error)
+		default:
+			failed++
+			fmt.Printf("failed   %s: %s\n", result.File, result.Error)
+		}
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+func runExport(ctx context.Context, markdownUseCase domain.ArticleMarkdownUsecase, args []string) int {
+	if len(args) != 1 {
+		usage()
+	}
+	dir := args[0]
+
+	files, err := markdownUseCase.ExportMarkdown(ctx)
+	if err != nil {
+		log.Printf("Export failed: %v", err)
+		return 1
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Printf("Failed to create %s: %v", dir, err)
+		return 1
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file.Name), file.Content, 0o644); err != nil {
+			log.Printf("Failed to write %s: %v", file.Name, err)
+			return 1
+		}
+	}
+
+	fmt.Printf("Exported %d articles to %s\n", len(files), dir)
+	return 0
+}
+
+// readMarkdownSource reads a single .md file, a .zip archive, or every
+// Markdown file below a directory
+func readMarkdownSource(source string) ([]domain.MarkdownFile, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(filepath.Ext(source), ".zip") {
+			return domain.ReadMarkdownArchive(data)
+		}
+		return []domain.MarkdownFile{{Name: filepath.Base(source), Content: data}}, nil
+	}
+
+	var files []domain.MarkdownFile
+	err = filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !domain.IsMarkdownFile(entry.Name()) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, _ := filepath.Rel(source, path)
+		files = append(files, domain.MarkdownFile{Name: filepath.ToSlash(name), Content: data})
+		return nil
+	})
+	return files, err
+}
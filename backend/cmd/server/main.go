@@ -13,6 +13,7 @@ import (
 	"github.com/joho/godotenv"
 
 	handler "portfolio/internal/delivery/http"
+	"portfolio/internal/domain"
 	"portfolio/internal/infrastructure/cloudinary"
 	"portfolio/internal/infrastructure/config"
 	"portfolio/internal/infrastructure/db"
@@ -48,18 +49,53 @@ func main() {
 	homepageRepo := repository.NewHomepageRepository(database.DB)
 	courseRepo := repository.NewCoursePgRepository(database)
 	articleRepo := repository.NewArticlePostgresRepository(database)
+	articleRevisionRepo := repository.NewArticleRevisionPostgresRepository(database)
+	articleRelationRepo := repository.NewArticleRelationPostgresRepository(database)
 	categoryRepo := repository.NewCategoryPostgresRepository(database)
+	tagRepo := repository.NewTagPostgresRepository(database)
+	seriesRepo := repository.NewSeriesPostgresRepository(database)
+	commentRepo := repository.NewCommentPostgresRepository(database)
 	newsletterRepo := repository.NewNewsletterPostgresRepository(database)
+	analyticsRepo := repository.NewAnalyticsPostgresRepository(database)
+	sitemapRepo := repository.NewSitemapPostgresRepository(database)
+	seoRepo := repository.NewSEOPostgresRepository(database)
+	slugRedirectRepo := repository.NewSlugRedirectPostgresRepository(database)
+	articleWorkflowRepo := repository.NewArticleWorkflowPostgresRepository(database)
+	articleTranslationRepo := repository.NewArticleTranslationPostgresRepository(database)
+	previewRepo := repository.NewPreviewPostgresRepository(database)
+	authorRepo := repository.NewAuthorPostgresRepository(database)
+	featuredRepo := repository.NewFeaturedPostgresRepository(database)
 
 	// Initialize use cases
 	userUseCase := usecase.NewUserUseCase(userRepo, zapLogger)
-	projectUseCase := usecase.NewProjectUseCase(projectRepo)
+	projectUseCase := usecase.NewProjectUseCase(projectRepo, slugRedirectRepo)
 	localeUseCase := usecase.NewLocaleUseCase(localeRepo, zapLogger)
 	homepageUseCase := usecase.NewHomepageUsecase(homepageRepo)
-	courseUseCase := usecase.NewCourseUsecase(courseRepo)
+	courseUseCase := usecase.NewCourseUsecase(courseRepo, slugRedirectRepo)
 	categoryUseCase := usecase.NewCategoryUsecase(categoryRepo, 10*time.Second)
-	articleUseCase := usecase.NewArticleUsecase(articleRepo, categoryRepo, database.DB, 10*time.Second)
+	articleUseCase := usecase.NewArticleUsecase(articleRepo, categoryRepo, articleRevisionRepo, articleRelationRepo, seriesRepo, slugRedirectRepo, articleWorkflowRepo, articleTranslationRepo, domain.NewContentLinter(), database.DB, 10*time.Second)
 	newsletterUseCase := usecase.NewNewsletterUsecase(newsletterRepo, 10*time.Second)
+	tagUseCase := usecase.NewTagUsecase(tagRepo, articleRepo, 10*time.Second)
+	seriesUseCase := usecase.NewSeriesUsecase(seriesRepo, articleRepo, 10*time.Second)
+	commentUseCase := usecase.NewCommentUsecase(commentRepo, articleRepo, 10*time.Second)
+	analyticsUseCase := usecase.NewAnalyticsUsecase(analyticsRepo, articleRepo, 10*time.Second)
+	site := domain.NewSite(cfg.SiteName, cfg.SiteURL, cfg.SiteDescription)
+	site.Author = cfg.SiteAuthor
+	site.TwitterHandle = cfg.SiteTwitterHandle
+	site.DefaultImage = cfg.SiteDefaultImage
+	feedUseCase := usecase.NewFeedUsecase(articleUseCase, categoryRepo, tagRepo, site, 10*time.Second)
+	sitemapUseCase := usecase.NewSitemapUsecase(sitemapRepo, site, 10*time.Second)
+	seoUseCase := usecase.NewSEOUsecase(seoRepo, articleRepo, projectRepo, courseRepo, site, 10*time.Second)
+	articleMarkdownUseCase := usecase.NewArticleMarkdownUsecase(articleUseCase, categoryRepo, 10*time.Second)
+	previewUseCase := usecase.NewPreviewUsecase(previewRepo, articleRepo, seriesRepo, courseRepo, 10*time.Second)
+	authorUseCase := usecase.NewAuthorUsecase(authorRepo, articleRepo, 10*time.Second)
+	featuredUseCase := usecase.NewFeaturedUsecase(featuredRepo, articleRepo, articleTranslationRepo, projectRepo, courseRepo, 10*time.Second)
+
+	// Start the scheduled publishing loop
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	publishScheduler := usecase.NewPublishScheduler(articleRepo, courseRepo, zapLogger, time.Duration(cfg.PublishSchedulerInterval)*time.Second)
+	publishScheduler.Start(schedulerCtx)
 
 	// Initialize Cloudinary client
 	cloudinaryClient, err := cloudinary.NewCloudinaryClient()
@@ -73,13 +109,24 @@ func main() {
 	courseHandler := handler.NewCourseHandler(courseUseCase, cloudinaryClient)
 	projectHandler := handler.NewProjectHandler(projectUseCase, zapLogger)
 	articleHandler := handler.NewArticleHandler(articleUseCase, newsletterUseCase, categoryUseCase, cloudinaryClient)
+	tagHandler := handler.NewTagHandler(tagUseCase)
+	seriesHandler := handler.NewSeriesHandler(seriesUseCase)
+	commentHandler := handler.NewCommentHandler(commentUseCase)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsUseCase)
+	feedHandler := handler.NewFeedHandler(feedUseCase)
+	sitemapHandler := handler.NewSitemapHandler(sitemapUseCase)
+	seoHandler := handler.NewSEOHandler(seoUseCase)
+	articleMarkdownHandler := handler.NewArticleMarkdownHandler(articleMarkdownUseCase)
+	previewHandler := handler.NewPreviewHandler(previewUseCase)
+	authorHandler := handler.NewAuthorHandler(authorUseCase)
+	featuredHandler := handler.NewFeaturedHandler(featuredUseCase)
 
 	// Initialize HTTP server
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	router := handler.NewRouter(userUseCase, projectUseCase, localeUseCase, homepageHandler, courseHandler, projectHandler, articleHandler, zapLogger, database.DB)
+	router := handler.NewRouter(userUseCase, projectUseCase, localeUseCase, homepageHandler, courseHandler, projectHandler, articleHandler, tagHandler, seriesHandler, commentHandler, analyticsHandler, feedHandler, sitemapHandler, seoHandler, articleMarkdownHandler, previewHandler, authorHandler, featuredHandler, zapLogger, database.DB)
 
 	server := &http.Server{
 		Addr:    cfg.ServerAddress,
@@ -100,6 +147,7 @@ func main() {
 	<-quit
 
 	zapLogger.Info("Shutting down server...")
+	stopScheduler()
 
 	// Give outstanding requests a deadline for completion
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnalyticsHandler serves the admin analytics dashboard
+type AnalyticsHandler struct {
+	analyticsUsecase domain.AnalyticsUsecase
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsUC domain.AnalyticsUsecase) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsUsecase: analyticsUC,
+	}
+}
+
+// GET /api/admin/analytics/articles?from=YYYY-MM-DD&to=YYYY-MM-DD&article=&limit=
+// Defaults to the last 30 days. Returns totals, a per-day series, the top
+// articles, a per-category breakdown and the top referrer domains.
+func (h *AnalyticsHandler) GetArticleAnalytics(c *gin.Context) {
+	params := domain.ArticleAnalyticsParams{
+		ArticleID: c.Query("article"),
+	}
+
+	if from := c.Query("from"); from != "" {
+		t, err := parseSearchDate(from)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date, use YYYY-MM-DD"})
+			return
+		}
+		params.From = t
+	}
+
+	if to := c.Query("to"); to != "" {
+		t, err := parseSearchDate(to)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date, use YYYY-MM-DD"})
+			return
+		}
+		params.To = t
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		params.Limit = n
+	}
+
+	report, err := h.analyticsUsecase.GetArticleAnalytics(c.Request.Context(), params)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidAnalyticsRange:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case domain.ErrArticleNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
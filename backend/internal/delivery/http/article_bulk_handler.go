@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST /api/admin/articles/bulk
+// Applies publish, unpublish, feature, unfeature, change_category, add_tag,
+// remove_tag or delete to up to bulk.MaxItems articles in one transaction.
+func (h *ArticleHandler) BulkArticles(c *gin.Context) {
+	var req bulk.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.articleUsecase.BulkArticles(c.Request.Context(), req, currentActor(c))
+	switch err {
+	case domain.ErrTransitionForbidden:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case domain.ErrCategoryNotFound, domain.ErrInvalidTagName:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		respondBulk(c, report, err)
+	}
+}
@@ -4,12 +4,17 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"portfolio/internal/domain"
 	"portfolio/internal/infrastructure/cloudinary"
+	"portfolio/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -33,18 +38,31 @@ func NewArticleHandler(articleUC domain.ArticleUsecase, newsletterUC domain.News
 // Article endpoints
 
 type ArticleResponse struct {
-	ID          string                `json:"id"`
-	Title       string                `json:"title"`
-	Excerpt     string                `json:"excerpt"`
-	Content     string                `json:"content,omitempty"`
-	Category    CategoryResponse      `json:"category"`
-	PublishedAt string                `json:"publishedAt"`
-	ReadTime    int                   `json:"readTime"`
-	Slug        string                `json:"slug"`
-	Featured    bool                  `json:"featured"`
-	Author      AuthorResponse        `json:"author"`
-	Tags        []string              `json:"tags,omitempty"`
-	Stats       *ArticleStatsResponse `json:"stats,omitempty"`
+	ID          string                   `json:"id"`
+	Title       string                   `json:"title"`
+	Excerpt     string                   `json:"excerpt"`
+	Content     string                   `json:"content,omitempty"`
+	Format      string                   `json:"format,omitempty"`
+	Language    string                   `json:"language"`
+	Category    CategoryResponse         `json:"category"`
+	PublishedAt string                   `json:"publishedAt"`
+	ReadTime    int                      `json:"readTime"`
+	Slug        string                   `json:"slug"`
+	Featured    bool                     `json:"featured"`
+	Published   bool                     `json:"published"`
+	Status      string                   `json:"status,omitempty"`
+	PublishAt   *time.Time               `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time               `json:"unpublishAt,omitempty"`
+	Author      AuthorResponse           `json:"author"`
+	Tags        []string                 `json:"tags,omitempty"`
+	Stats       *ArticleStatsResponse    `json:"stats,omitempty"`
+	Headline    string                   `json:"headline,omitempty"`
+	Series      *domain.SeriesNavigation `json:"series,omitempty"`
+
+	SourceLanguage string                   `json:"sourceLanguage,omitempty"`
+	Translations   []domain.TranslationLink `json:"translations,omitempty"`
+
+	Lint *domain.LintReport `json:"lint,omitempty"`
 }
 
 type CategoryResponse struct {
@@ -56,6 +74,8 @@ type CategoryResponse struct {
 type AuthorResponse struct {
 	Name   string `json:"name"`
 	Avatar string `json:"avatar,omitempty"`
+	Bio    string `json:"bio,omitempty"`
+	Slug   string `json:"slug,omitempty"`
 }
 
 type ArticleStatsResponse struct {
@@ -69,47 +89,140 @@ type ArticleListResponse struct {
 	Total      int               `json:"total"`
 	Page       int               `json:"page"`
 	TotalPages int               `json:"totalPages"`
+	NextCursor string            `json:"nextCursor,omitempty"`
 }
 
-// GET /api/articles
-func (h *ArticleHandler) GetArticles(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	category := c.Query("category")
-	featuredQuery := c.Query("featured")
-	publishedQuery := c.Query("published")
-	allQuery := c.Query("all") // For admin to see all articles
+// ArticleListQuery is the query string of GET /api/articles
+type ArticleListQuery struct {
+	Page        int    `form:"page"`
+	Limit       int    `form:"limit"`
+	Category    string `form:"category"`
+	Tag         string `form:"tag"`      // one or more comma-separated tag slugs
+	TagMatch    string `form:"tagMatch"` // any (default) or all
+	Author      string `form:"author"`   // author page slug
+	From        string `form:"from"`     // YYYY-MM-DD or RFC 3339, inclusive
+	To          string `form:"to"`       // YYYY-MM-DD (whole day) or RFC 3339, exclusive
+	MinReadTime int    `form:"minReadTime"`
+	Sort        string `form:"sort"` // newest, oldest, most_viewed, most_liked or trending
+	Featured    *bool  `form:"featured"`
+	Published   *bool  `form:"published"`
+	All         bool   `form:"all"` // admin: published and unpublished
+	Status      string `form:"status"`
+}
 
-	log.Printf("GetArticles called - page: %d, limit: %d, category: %s, all: %s", page, limit, category, allQuery)
+var errPublishedWithAll = errors.New("published cannot be combined with all=true")
 
+// params turns the query into validated list parameters
+func (q ArticleListQuery) params() (domain.ArticleListParams, error) {
 	params := domain.ArticleListParams{
-		Page:     page,
-		Limit:    limit,
-		Category: category,
+		Page:        q.Page,
+		Limit:       q.Limit,
+		Category:    q.Category,
+		TagMatch:    q.TagMatch,
+		Author:      q.Author,
+		MinReadTime: q.MinReadTime,
+		Sort:        q.Sort,
+		Featured:    q.Featured,
+		Published:   q.Published,
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 || params.Limit > 100 {
+		params.Limit = 10
+	}
+
+	for _, tag := range strings.Split(q.Tag, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			params.Tags = append(params.Tags, tag)
+		}
 	}
 
-	// Handle featured filter
-	if featuredQuery != "" {
-		featured := featuredQuery == "true"
-		params.Featured = &featured
+	if q.From != "" {
+		t, err := parseSearchDate(q.From)
+		if err != nil {
+			return params, errors.New("invalid from date")
+		}
+		params.From = &t
+	}
+	if q.To != "" {
+		t, err := parseSearchDate(q.To)
+		if err != nil {
+			return params, errors.New("invalid to date")
+		}
+		// A bare date includes the whole day
+		if len(q.To) == len("2006-01-02") {
+			t = t.AddDate(0, 0, 1)
+		}
+		params.To = &t
 	}
 
-	// Handle published filter
-	// If "all=true" is passed (typically from admin), show all articles
-	if allQuery == "true" {
-		// Don't set Published, will show all articles
-		params.Published = nil
-		log.Printf("Admin mode: fetching all articles (published and unpublished)")
-	} else if publishedQuery != "" {
-		// Explicit published filter
-		published := publishedQuery == "true"
-		params.Published = &published
-	} else {
-		// Default: only show published articles for public
+	// Workflow status filter, e.g. ?all=true&status=in_review for the review queue
+	if q.Status != "" {
+		if !domain.IsValidArticleStatus(q.Status) {
+			return params, domain.ErrInvalidArticleStatus
+		}
+		params.Status = q.Status
+	}
+
+	// all=true (typically from admin) shows published and unpublished
+	// articles; otherwise only published ones unless asked explicitly
+	if q.All {
+		if q.Published != nil {
+			return params, errPublishedWithAll
+		}
+	} else if params.Published == nil {
 		published := true
 		params.Published = &published
 	}
 
+	return params, nil
+}
+
+// GET /api/articles
+// Filters by category, tags (?tag=go,rust&tagMatch=all), author slug, publish
+// date range and minimum read time, sorted by ?sort=. With ?cursor= (empty
+// for the first page) the list is keyset-paginated for infinite scroll: pass
+// nextCursor back until it is missing. Total and page are not computed in
+// that mode.
+func (h *ArticleHandler) GetArticles(c *gin.Context) {
+	var query ArticleListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Printf("GetArticles called - page: %d, limit: %d, category: %s, all: %t", query.Page, query.Limit, query.Category, query.All)
+
+	params, err := query.params()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cursor, ok := cursorParam(c)
+	if !ok {
+		return
+	}
+	if cursor != nil {
+		params.Cursor = cursor
+		params.Limit = cursorLimit(query.Limit)
+	}
+
+	// Public lists show published translations in the reader's language
+	if !query.All {
+		lang, ok := readerLanguage(c)
+		if !ok {
+			return
+		}
+		params.Language = lang.Language()
+	}
+
+	if err := params.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	result, err := h.articleUsecase.GetArticles(c.Request.Context(), params)
 	if err != nil {
 		log.Printf("Error fetching articles: %v", err)
@@ -124,6 +237,7 @@ func (h *ArticleHandler) GetArticles(c *gin.Context) {
 		Total:      result.Total,
 		Page:       result.Page,
 		TotalPages: result.TotalPages,
+		NextCursor: result.NextCursor,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -148,13 +262,21 @@ func (h *ArticleHandler) GetFeaturedArticles(c *gin.Context) {
 		limit = 3
 	}
 
+	lang, ok := readerLanguage(c)
+	if !ok {
+		return
+	}
+
 	params := domain.ArticleListParams{
-		Page:  1,
-		Limit: limit,
+		Page:     1,
+		Limit:    limit,
+		Language: lang.Language(),
 	}
 
-	featured := true
+	// Public endpoint: only articles the public may see
+	featured, published := true, true
 	params.Featured = &featured
+	params.Published = &published
 
 	result, err := h.articleUsecase.GetArticles(c.Request.Context(), params)
 	if err != nil {
@@ -172,12 +294,24 @@ func (h *ArticleHandler) GetFeaturedArticles(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// GET /api/articles/:slug
+// GET /api/articles/:slug?format=html|markdown|text&lang=
+// Old slugs of a renamed article answer with a 301 to the current one. The
+// article is shown in the language from ?lang=, the slug or Accept-Language
+// when it has a published translation, and in its source language otherwise.
 func (h *ArticleHandler) GetArticle(c *gin.Context) {
 	slug := c.Param("slug")
+	format := c.DefaultQuery("format", domain.ContentFormatEditorJS)
+
+	lang, ok := readerLanguage(c)
+	if !ok {
+		return
+	}
 
-	article, err := h.articleUsecase.GetArticleBySlug(c.Request.Context(), slug)
+	article, err := h.articleUsecase.GetArticleBySlug(c.Request.Context(), slug, lang)
 	if err != nil {
+		if respondSlugRedirect(c, err) {
+			return
+		}
 		if err == domain.ErrArticleNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
 			return
@@ -186,10 +320,25 @@ func (h *ArticleHandler) GetArticle(c *gin.Context) {
 		return
 	}
 
-	// Track article view
-	go h.articleUsecase.TrackArticleView(c.Request.Context(), article.ID)
+	content, err := domain.RenderContent(article.Content, format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Track article view; the request context is cancelled once we respond
+	visitor := visitorFromRequest(c)
+	trackCtx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		if err := h.articleUsecase.TrackArticleView(trackCtx, article.ID, visitor); err != nil {
+			log.Printf("Failed to track article view: %v", err)
+		}
+	}()
 
 	response := mapArticleToResponse(article)
+	response.Content = content
+	response.Format = format
+	c.Header("Content-Language", article.Language)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -219,21 +368,33 @@ func (h *ArticleHandler) CreateArticle(c *gin.Context) {
 		return
 	}
 
-	// TODO: Get author ID from JWT token
-	// For now, get the first admin user from the database
-	authorID, err := h.articleUsecase.GetDefaultAuthorID(c.Request.Context())
-	if err != nil {
-		log.Printf("Failed to get default author: %v", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get author information"})
+	// The signed-in user is the author; admins can reassign it later
+	author := currentActor(c)
+	if author.ID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
 
-	article, err := h.articleUsecase.CreateArticle(c.Request.Context(), req, authorID)
+	article, err := h.articleUsecase.CreateArticle(c.Request.Context(), req, author)
 	if err != nil {
+		if respondContentLint(c, err) {
+			return
+		}
 		if err == domain.ErrCategoryNotFound {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Category not found"})
 			return
 		}
+		if err == domain.ErrTransitionForbidden {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if err == domain.ErrInvalidSchedule || err == domain.ErrUnsupportedLanguage || err == domain.ErrInvalidTagName {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -252,8 +413,11 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 		return
 	}
 
-	article, err := h.articleUsecase.UpdateArticle(c.Request.Context(), articleID, req)
+	article, err := h.articleUsecase.UpdateArticle(c.Request.Context(), articleID, req, currentActor(c))
 	if err != nil {
+		if respondContentLint(c, err) {
+			return
+		}
 		if err == domain.ErrArticleNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
 			return
@@ -262,6 +426,21 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Category not found"})
 			return
 		}
+		if err == domain.ErrTransitionForbidden || err == domain.ErrArticleLocked || err == domain.ErrNotArticleAuthor {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if err == domain.ErrInvalidTransition {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err == domain.ErrInvalidSchedule || err == domain.ErrUnsupportedLanguage || err == domain.ErrInvalidTagName {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -274,12 +453,16 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 func (h *ArticleHandler) DeleteArticle(c *gin.Context) {
 	articleID := c.Param("id")
 
-	err := h.articleUsecase.DeleteArticle(c.Request.Context(), articleID)
+	err := h.articleUsecase.DeleteArticle(c.Request.Context(), articleID, currentActor(c))
 	if err != nil {
 		if err == domain.ErrArticleNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
 			return
 		}
+		if err == domain.ErrTransitionForbidden {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -323,9 +506,42 @@ func (h *ArticleHandler) SearchArticles(c *gin.Context) {
 	}
 
 	params := domain.SearchParams{
-		Query: query,
-		Page:  page,
-		Limit: limit,
+		Query:    query,
+		Page:     page,
+		Limit:    limit,
+		Category: c.Query("category"),
+		Tag:      c.Query("tag"),
+	}
+
+	// lang picks the text search configuration; "auto" or empty searches every language
+	if lang := c.Query("lang"); lang != "" && lang != "auto" {
+		if !domain.IsSupportedLanguage(lang) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported search language"})
+			return
+		}
+		params.Language = lang
+	}
+
+	if from := c.Query("from"); from != "" {
+		t, err := parseSearchDate(from)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date"})
+			return
+		}
+		params.From = &t
+	}
+
+	if to := c.Query("to"); to != "" {
+		t, err := parseSearchDate(to)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date"})
+			return
+		}
+		// A bare date includes the whole day
+		if len(to) == len("2006-01-02") {
+			t = t.AddDate(0, 0, 1)
+		}
+		params.To = &t
 	}
 
 	result, err := h.articleUsecase.SearchArticles(c.Request.Context(), params)
@@ -344,6 +560,14 @@ func (h *ArticleHandler) SearchArticles(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// parseSearchDate accepts either YYYY-MM-DD or RFC3339
+func parseSearchDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 // Newsletter endpoints
 
 type NewsletterSubscribeRequest struct {
@@ -423,7 +647,7 @@ func (h *ArticleHandler) GetSubscribers(c *gin.Context) {
 func (h *ArticleHandler) TrackArticleView(c *gin.Context) {
 	articleID := c.Param("id")
 
-	err := h.articleUsecase.TrackArticleView(c.Request.Context(), articleID)
+	err := h.articleUsecase.TrackArticleView(c.Request.Context(), articleID, visitorFromRequest(c))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -433,16 +657,98 @@ func (h *ArticleHandler) TrackArticleView(c *gin.Context) {
 }
 
 // POST /api/analytics/articles/:id/like
+// Toggles the like of the current visitor.
 func (h *ArticleHandler) TrackArticleLike(c *gin.Context) {
-	articleID := c.Param("id")
+	h.respondLike(c, c.Param("id"), true)
+}
+
+// GET /api/public/articles/:slug/like
+func (h *ArticleHandler) GetArticleLike(c *gin.Context) {
+	h.respondLikeBySlug(c, false)
+}
 
-	likes, err := h.articleUsecase.TrackArticleLike(c.Request.Context(), articleID)
+// POST /api/public/articles/:slug/like
+// Likes the article for the current customer or anonymous visitor, or
+// removes the like when there already is one.
+func (h *ArticleHandler) ToggleArticleLike(c *gin.Context) {
+	h.respondLikeBySlug(c, true)
+}
+
+func (h *ArticleHandler) respondLikeBySlug(c *gin.Context, toggle bool) {
+	article, err := h.articleUsecase.GetArticleBySlug(c.Request.Context(), c.Param("slug"), domain.LanguageChoice{})
+
+	// Likes through an old link land on the renamed article
+	var redirect *domain.SlugRedirect
+	if errors.As(err, &redirect) {
+		article, err = h.articleUsecase.GetArticleBySlug(c.Request.Context(), redirect.Slug, domain.LanguageChoice{})
+	}
 	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"likes": likes})
+	h.respondLike(c, article.ID, toggle)
+}
+
+func (h *ArticleHandler) respondLike(c *gin.Context, articleID string, toggle bool) {
+	visitor := visitorFromRequest(c)
+
+	var state *domain.LikeState
+	var err error
+	if toggle {
+		state, err = h.articleUsecase.ToggleArticleLike(c.Request.Context(), articleID, visitor)
+	} else {
+		state, err = h.articleUsecase.GetArticleLike(c.Request.Context(), articleID, visitor)
+	}
+	if err != nil {
+		switch err {
+		case domain.ErrArticleNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+		case domain.ErrUnknownVisitor:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case domain.ErrTooManyVisitors:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, state)
+}
+
+// visitorFromRequest identifies the caller for view and like tracking: the
+// customer ID when a valid token was sent, otherwise a salted hash of IP and
+// user agent, together with the client-generated X-Visitor-ID header when
+// sent. The header only tells apart visitors at one address; they are capped
+// by MaxVisitorsPerNetwork, so rotating it cannot inflate the counts.
+// The frontend forwards document.referrer as X-Referrer, since the Referer of
+// its API calls is the site itself.
+func visitorFromRequest(c *gin.Context) domain.Visitor {
+	visitor := domain.Visitor{
+		UserID:    currentUserID(c),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.GetHeader("X-Referrer"),
+	}
+	if visitor.Referrer == "" {
+		visitor.Referrer = c.Request.Referer()
+	}
+
+	ip := c.ClientIP()
+	if ip == "" {
+		return visitor
+	}
+	visitor.Network = utils.HashVisitor("ip", ip, visitor.UserAgent)
+	visitor.Fingerprint = visitor.Network
+	if visitorID := strings.TrimSpace(c.GetHeader("X-Visitor-ID")); visitorID != "" {
+		visitor.Fingerprint = utils.HashVisitor("id", visitorID, ip, visitor.UserAgent)
+	}
+
+	return visitor
 }
 
 // GET /api/analytics/articles/:id/stats
@@ -520,12 +826,28 @@ func (h *ArticleHandler) ListImages(c *gin.Context) {
 
 // Helper functions
 
+// currentUserID returns the authenticated user's ID set by the JWT middleware, or "" if absent
+func currentUserID(c *gin.Context) string {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		return ""
+	}
+	id, _ := userID.(string)
+	return id
+}
+
+// currentActor is the signed-in user with the role from their JWT
+func currentActor(c *gin.Context) domain.Actor {
+	return domain.Actor{ID: currentUserID(c), Role: c.GetString("user_role")}
+}
+
 func mapArticleToResponse(article *domain.Article) ArticleResponse {
 	return ArticleResponse{
-		ID:      article.ID,
-		Title:   article.Title,
-		Excerpt: article.Excerpt,
-		Content: article.Content,
+		ID:       article.ID,
+		Title:    article.Title,
+		Excerpt:  article.Excerpt,
+		Content:  article.Content,
+		Language: article.Language,
 		Category: CategoryResponse{
 			Name:    article.Category.Name,
 			Color:   article.Category.Color,
@@ -535,11 +857,24 @@ func mapArticleToResponse(article *domain.Article) ArticleResponse {
 		ReadTime:    article.ReadTime,
 		Slug:        article.Slug,
 		Featured:    article.Featured,
+		Published:   article.Published,
+		Status:      article.Status,
+		PublishAt:   article.PublishAt,
+		UnpublishAt: article.UnpublishAt,
 		Author: AuthorResponse{
 			Name:   article.Author.Name,
 			Avatar: article.Author.Avatar,
+			Bio:    article.Author.Bio,
+			Slug:   article.Author.Slug,
 		},
-		Tags: article.Tags,
+		Tags:     article.Tags,
+		Headline: article.SearchHeadline,
+		Series:   article.Series,
+
+		SourceLanguage: article.SourceLanguage,
+		Translations:   article.Translations,
+
+		Lint: article.Lint,
 	}
 }
 
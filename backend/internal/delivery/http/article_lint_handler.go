@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type lintArticleRequest struct {
+	Content string `json:"content" binding:"required"`
+}
+
+// POST /api/admin/articles/lint
+// Dry run of the content checks made on save, so the editor can show issues
+// before publishing. Nothing is saved.
+func (h *ArticleHandler) LintArticle(c *gin.Context) {
+	var req lintArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.articleUsecase.LintContent(req.Content))
+}
+
+// respondContentLint answers a save refused by a blocking lint rule with 422
+// and the full report, and reports whether err was such a refusal
+func respondContentLint(c *gin.Context, err error) bool {
+	lintErr, ok := domain.AsContentLintError(err)
+	if !ok {
+		return false
+	}
+
+	c.JSON(http.StatusUnprocessableEntity, gin.H{
+		"error": lintErr.Error(),
+		"lint":  lintErr.Report,
+	})
+	return true
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ArticleMarkdownHandler imports and exports articles as Markdown files
+type ArticleMarkdownHandler struct {
+	markdownUsecase domain.ArticleMarkdownUsecase
+}
+
+// NewArticleMarkdownHandler creates a new article Markdown handler
+func NewArticleMarkdownHandler(markdownUC domain.ArticleMarkdownUsecase) *ArticleMarkdownHandler {
+	return &ArticleMarkdownHandler{
+		markdownUsecase: markdownUC,
+	}
+}
+
+// POST /api/admin/articles/import
+// Multipart upload of one or more "files": .md files with YAML front matter,
+// or .zip archives of them. Responds with a per-file report.
+func (h *ArticleMarkdownHandler) ImportArticles(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxMarkdownImportSize)
+	form, err := c.MultipartForm()
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Upload is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Expected a multipart upload"})
+		return
+	}
+
+	var files []domain.MarkdownFile
+	for _, header := range form.File["files"] {
+		f, err := header.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read " + header.Filename})
+			return
+		}
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read " + header.Filename})
+			return
+		}
+
+		switch {
+		case strings.EqualFold(path.Ext(header.Filename), ".zip"):
+			archived, err := domain.ReadMarkdownArchive(data)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %v", header.Filename, err)})
+				return
+			}
+			for _, file := range archived {
+				file.Name = header.Filename + "/" + file.Name
+				files = append(files, file)
+			}
+		case domain.IsMarkdownFile(header.Filename):
+			files = append(files, domain.MarkdownFile{Name: header.Filename, Content: data})
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": header.Filename + ": only .md and .zip files can be imported"})
+			return
+		}
+	}
+
+	if len(files) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No Markdown files provided"})
+		return
+	}
+
+	results, err := h.markdownUsecase.ImportMarkdown(c.Request.Context(), files, currentActor(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	counts := map[string]int{
+		domain.ImportStatusCreated: 0,
+		domain.ImportStatusSkipped: 0,
+		domain.ImportStatusFailed:  0,
+	}
+	for _, result := range results {
+		counts[result.Status]++
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"results": results,
+		"created": counts[domain.ImportStatusCreated],
+		"skipped": counts[domain.ImportStatusSkipped],
+		"failed":  counts[domain.ImportStatusFailed],
+	})
+}
+
+// GET /api/admin/articles/export
+// Downloads every article, drafts included, as a zip of Markdown files
+func (h *ArticleMarkdownHandler) ExportArticles(c *gin.Context) {
+	files, err := h.markdownUsecase.ExportMarkdown(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := domain.WriteMarkdownArchive(&buf, files); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("articles-%s.zip", time.Now().Format("20060102"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RelatedArticleResponse struct {
+	ArticleResponse
+	Score  float64 `json:"score"`
+	Pinned bool    `json:"pinned"`
+}
+
+type SetRelatedPinsRequest struct {
+	ArticleIDs []string `json:"articleIds"`
+}
+
+// GET /api/public/articles/:slug/related
+func (h *ArticleHandler) GetRelatedArticles(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "4"))
+
+	related, err := h.articleUsecase.GetRelatedArticles(c.Request.Context(), c.Param("slug"), limit)
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"articles": mapRelatedToResponse(related)})
+}
+
+// GET /api/admin/articles/:id/related
+func (h *ArticleHandler) GetRelatedPins(c *gin.Context) {
+	ids, err := h.articleUsecase.GetRelatedPins(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"articleIds": ids})
+}
+
+// PUT /api/admin/articles/:id/related
+// Replaces the pinned related articles; an empty list clears the overrides.
+func (h *ArticleHandler) SetRelatedPins(c *gin.Context) {
+	var req SetRelatedPinsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	related, err := h.articleUsecase.SetRelatedPins(c.Request.Context(), c.Param("id"), req.ArticleIDs)
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		if err == domain.ErrInvalidRelatedArticle {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"articles": mapRelatedToResponse(related)})
+}
+
+func mapRelatedToResponse(related []domain.RelatedArticle) []RelatedArticleResponse {
+	responses := make([]RelatedArticleResponse, len(related))
+	for i, r := range related {
+		responses[i] = RelatedArticleResponse{
+			ArticleResponse: mapArticleToResponse(r.Article),
+			Score:           r.Score,
+			Pinned:          r.Pinned,
+		}
+		// Related lists are teasers; the body is fetched with the article itself
+		responses[i].Content = ""
+	}
+	return responses
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /api/admin/articles/:id/revisions
+func (h *ArticleHandler) GetArticleRevisions(c *gin.Context) {
+	articleID := c.Param("id")
+
+	revisions, err := h.articleUsecase.GetArticleRevisions(c.Request.Context(), articleID)
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+// GET /api/admin/articles/:id/revisions/:revisionId
+func (h *ArticleHandler) GetArticleRevision(c *gin.Context) {
+	revision, err := h.articleUsecase.GetArticleRevision(c.Request.Context(), c.Param("id"), c.Param("revisionId"))
+	if err != nil {
+		if err == domain.ErrRevisionNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, revision)
+}
+
+// GET /api/admin/articles/:id/revisions/diff?from=&to=
+func (h *ArticleHandler) DiffArticleRevisions(c *gin.Context) {
+	from := c.Query("from")
+	to := c.Query("to")
+	if from == "" || to == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Both from and to revision IDs are required"})
+		return
+	}
+
+	diff, err := h.articleUsecase.DiffArticleRevisions(c.Request.Context(), c.Param("id"), from, to)
+	if err != nil {
+		if err == domain.ErrRevisionNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// POST /api/admin/articles/:id/revisions/:revisionId/restore
+func (h *ArticleHandler) RestoreArticleRevision(c *gin.Context) {
+	article, err := h.articleUsecase.RestoreArticleRevision(c.Request.Context(), c.Param("id"), c.Param("revisionId"), currentActor(c))
+	if err != nil {
+		if respondContentLint(c, err) {
+			return
+		}
+		switch err {
+		case domain.ErrTransitionForbidden, domain.ErrArticleLocked, domain.ErrNotArticleAuthor:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case domain.ErrRevisionNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+		case domain.ErrArticleNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+		case domain.ErrCategoryNotFound:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Category of this revision no longer exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mapArticleToResponse(article))
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /api/admin/articles/:id/translations
+func (h *ArticleHandler) GetArticleTranslations(c *gin.Context) {
+	translations, err := h.articleUsecase.GetArticleTranslations(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"translations": translations})
+}
+
+// PUT /api/admin/articles/:id/translations/:lang
+// Creates or replaces the article's translation into lang.
+func (h *ArticleHandler) SaveArticleTranslation(c *gin.Context) {
+	var req domain.SaveArticleTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	translation, err := h.articleUsecase.SaveArticleTranslation(c.Request.Context(), c.Param("id"), c.Param("lang"), req, currentActor(c))
+	if err != nil {
+		if respondContentLint(c, err) {
+			return
+		}
+		h.respondTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+// DELETE /api/admin/articles/:id/translations/:lang
+func (h *ArticleHandler) DeleteArticleTranslation(c *gin.Context) {
+	if err := h.articleUsecase.DeleteArticleTranslation(c.Request.Context(), c.Param("id"), c.Param("lang"), currentActor(c)); err != nil {
+		h.respondTranslationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Translation deleted successfully"})
+}
+
+func (h *ArticleHandler) respondTranslationError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrArticleNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+	case domain.ErrTranslationNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Translation not found"})
+	case domain.ErrTransitionForbidden, domain.ErrArticleLocked, domain.ErrNotArticleAuthor:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case domain.ErrUnsupportedLanguage, domain.ErrTranslationSameLanguage:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case domain.ErrSlugAlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// readerLanguage reads the language a public reader asked for from ?lang=
+// and Accept-Language. It responds with 400 and returns false for an
+// unsupported ?lang=.
+func readerLanguage(c *gin.Context) (domain.LanguageChoice, bool) {
+	// Responses differ by Accept-Language, so shared caches must key on it
+	c.Header("Vary", "Accept-Language")
+
+	choice := domain.LanguageChoice{
+		Explicit:  c.Query("lang"),
+		Preferred: domain.PreferredLanguage(c.GetHeader("Accept-Language")),
+	}
+	if choice.Explicit != "" && !domain.IsSupportedLanguage(choice.Explicit) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": domain.ErrUnsupportedLanguage.Error()})
+		return choice, false
+	}
+	return choice, true
+}
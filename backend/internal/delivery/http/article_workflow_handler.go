@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST /api/admin/articles/:id/transitions
+// Moves the article through the editorial workflow. Writers may submit and
+// withdraw; editors and admins review, schedule, publish and archive.
+func (h *ArticleHandler) TransitionArticle(c *gin.Context) {
+	var req domain.ArticleTransitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	article, err := h.articleUsecase.TransitionArticle(c.Request.Context(), c.Param("id"), req, currentActor(c))
+	if err != nil {
+		if respondContentLint(c, err) {
+			return
+		}
+		switch err {
+		case domain.ErrArticleNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+		case domain.ErrTransitionForbidden, domain.ErrNotArticleAuthor:
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case domain.ErrInvalidTransition:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case domain.ErrInvalidArticleAction, domain.ErrTransitionCommentRequired,
+			domain.ErrSchedulePublishAtRequired, domain.ErrInvalidSchedule:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mapArticleToResponse(article))
+}
+
+// GET /api/admin/articles/:id/transitions
+// The article's workflow history with review comments, oldest first.
+func (h *ArticleHandler) GetArticleTransitions(c *gin.Context) {
+	transitions, err := h.articleUsecase.GetArticleTransitions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transitions": transitions})
+}
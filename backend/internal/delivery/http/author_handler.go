@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthorHandler handles author pages, profiles and article reassignment
+type AuthorHandler struct {
+	authorUsecase domain.AuthorUsecase
+}
+
+// NewAuthorHandler creates a new author handler
+func NewAuthorHandler(authorUC domain.AuthorUsecase) *AuthorHandler {
+	return &AuthorHandler{
+		authorUsecase: authorUC,
+	}
+}
+
+type AuthorPageResponse struct {
+	Author *domain.AuthorProfile `json:"author"`
+	ArticleListResponse
+}
+
+// GET /api/public/authors/:slug
+// The author's profile with a page of their published articles.
+func (h *AuthorHandler) GetAuthorPage(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	author, result, err := h.authorUsecase.GetAuthorPage(c.Request.Context(), c.Param("slug"), page, limit)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, AuthorPageResponse{
+		Author: author,
+		ArticleListResponse: ArticleListResponse{
+			Articles:   mapArticlesToResponse(result.Articles),
+			Total:      result.Total,
+			Page:       result.Page,
+			TotalPages: result.TotalPages,
+		},
+	})
+}
+
+// GET /api/admin/authors
+func (h *AuthorHandler) GetAuthors(c *gin.Context) {
+	authors, err := h.authorUsecase.GetAuthors(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": authors})
+}
+
+// GET /api/admin/authors/me
+func (h *AuthorHandler) GetMyProfile(c *gin.Context) {
+	author, err := h.authorUsecase.GetAuthor(c.Request.Context(), currentUserID(c))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, author)
+}
+
+// PUT /api/admin/authors/:id
+// Authors may edit their own profile; editing someone else's needs the admin role.
+func (h *AuthorHandler) UpdateProfile(c *gin.Context) {
+	userID := c.Param("id")
+	if userID == "me" {
+		userID = currentUserID(c)
+	}
+	if userID != currentUserID(c) && c.GetString("user_role") != "admin" {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+		return
+	}
+
+	var req domain.UpdateAuthorProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	author, err := h.authorUsecase.UpdateProfile(c.Request.Context(), userID, req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, author)
+}
+
+// PUT /api/admin/articles/:id/author
+func (h *AuthorHandler) ReassignArticle(c *gin.Context) {
+	var req domain.ReassignAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	article, err := h.authorUsecase.ReassignArticle(c.Request.Context(), c.Param("id"), req.AuthorID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapArticleToResponse(article))
+}
+
+func (h *AuthorHandler) respondError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrInvalidAuthor, domain.ErrInvalidAuthorSlug, domain.ErrInvalidSocialLink:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case domain.ErrAuthorNotFound, domain.ErrArticleNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case domain.ErrSlugAlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
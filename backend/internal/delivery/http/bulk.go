@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain/bulk"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondBulk answers a batch request. A saved batch gets 200; when an item
+// failed nothing was saved and the same report comes with 422. Errors about
+// the request as a whole are answered with 400, anything else with 500.
+func respondBulk(c *gin.Context, report *bulk.Report, err error) {
+	if err != nil {
+		switch err {
+		case bulk.ErrUnsupportedAction, bulk.ErrNoItems, bulk.ErrTooManyItems, bulk.ErrValueRequired:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if !report.Applied {
+		c.JSON(http.StatusUnprocessableEntity, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
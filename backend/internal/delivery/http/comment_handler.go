@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentHandler handles article comments and their moderation
+type CommentHandler struct {
+	commentUsecase domain.CommentUsecase
+}
+
+// NewCommentHandler creates a new comment handler
+func NewCommentHandler(commentUC domain.CommentUsecase) *CommentHandler {
+	return &CommentHandler{
+		commentUsecase: commentUC,
+	}
+}
+
+// GET /api/public/articles/:slug/comments
+// Returns approved comments as a thread tree.
+func (h *CommentHandler) GetArticleComments(c *gin.Context) {
+	comments, err := h.commentUsecase.GetArticleComments(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"comments": comments})
+}
+
+// POST /api/customer/articles/:slug/comments
+// New comments wait in the moderation queue before they are shown.
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	userID := currentUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req domain.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := h.commentUsecase.CreateComment(c.Request.Context(), c.Param("slug"), userID, req)
+	if err != nil {
+		switch err {
+		case domain.ErrArticleNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Article not found"})
+		case domain.ErrCommentRateLimited:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		case domain.ErrCommentEmpty, domain.ErrCommentTooLong, domain.ErrInvalidCommentParent:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"id":        comment.ID,
+		"parentId":  comment.ParentID,
+		"body":      comment.Body,
+		"status":    comment.Status,
+		"createdAt": comment.CreatedAt,
+	})
+}
+
+// GET /api/admin/comments?status=pending&articleId=&page=&limit=
+func (h *CommentHandler) ListComments(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	params := domain.CommentListParams{
+		Status:    c.Query("status"),
+		ArticleID: c.Query("articleId"),
+		Page:      page,
+		Limit:     limit,
+	}
+
+	result, err := h.commentUsecase.ListComments(c.Request.Context(), params)
+	if err != nil {
+		if err == domain.ErrInvalidCommentStatus {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PUT /api/admin/comments/:id/status
+func (h *CommentHandler) ModerateComment(c *gin.Context) {
+	var req domain.ModerateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := h.commentUsecase.ModerateComment(c.Request.Context(), c.Param("id"), req.Status, currentUserID(c))
+	if err != nil {
+		switch err {
+		case domain.ErrCommentNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+		case domain.ErrInvalidCommentStatus:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DELETE /api/admin/comments/:id
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	err := h.commentUsecase.DeleteComment(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if err == domain.ErrCommentNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"portfolio/internal/domain/bulk"
 	"portfolio/internal/domain/course"
 	"portfolio/internal/infrastructure/cloudinary"
 )
@@ -47,6 +48,9 @@ func (h *CourseHandler) CreateCourse(c *gin.Context) {
 
 	createdCourse, err := h.courseUC.CreateCourse(c.Request.Context(), req, instructorID)
 	if err != nil {
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -57,7 +61,7 @@ func (h *CourseHandler) CreateCourse(c *gin.Context) {
 	})
 }
 
-// GetCourse retrieves a course by slug (public)
+// GetCourse retrieves a course by slug (public); old slugs answer with a 301
 func (h *CourseHandler) GetCourse(c *gin.Context) {
 	slug := c.Param("slug")
 
@@ -71,6 +75,9 @@ func (h *CourseHandler) GetCourse(c *gin.Context) {
 
 	retrievedCourse, err := h.courseUC.GetCourse(c.Request.Context(), slug, userID)
 	if err != nil {
+		if respondSlugRedirect(c, err) {
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
@@ -101,6 +108,16 @@ func (h *CourseHandler) GetCourses(c *gin.Context) {
 		return
 	}
 
+	// ?cursor= (empty for the first page) switches to keyset pagination
+	cursor, ok := cursorParam(c)
+	if !ok {
+		return
+	}
+	if cursor != nil {
+		params.Cursor = cursor
+		params.Limit = cursorLimit(params.Limit)
+	}
+
 	response, err := h.courseUC.GetCourses(c.Request.Context(), params)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -142,6 +159,9 @@ func (h *CourseHandler) UpdateCourse(c *gin.Context) {
 
 	err := h.courseUC.UpdateCourse(c.Request.Context(), id, req)
 	if err != nil {
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -162,6 +182,18 @@ func (h *CourseHandler) DeleteCourse(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Course deleted successfully"})
 }
 
+// BulkCourses publishes, unpublishes or deletes many courses in one transaction
+func (h *CourseHandler) BulkCourses(c *gin.Context) {
+	var req bulk.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.courseUC.BulkCourses(c.Request.Context(), req)
+	respondBulk(c, report, err)
+}
+
 // CreateSection creates a new section in a course
 func (h *CourseHandler) CreateSection(c *gin.Context) {
 	var req course.CreateSectionRequest
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain/pagination"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxCursorLimit caps the page size of keyset-paginated public lists
+const maxCursorLimit = 50
+
+// cursorParam reads ?cursor= for keyset pagination. It returns nil when the
+// parameter is absent, so the list keeps its page/total mode, and the start
+// of the list when it is present but empty. An invalid cursor is answered
+// with 400 and false.
+func cursorParam(c *gin.Context) (*pagination.Cursor, bool) {
+	raw, present := c.GetQuery("cursor")
+	if !present {
+		return nil, true
+	}
+
+	cursor, err := pagination.Decode(raw)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return cursor, true
+}
+
+// cursorLimit keeps a keyset page size within 1..maxCursorLimit
+func cursorLimit(limit int) int {
+	if limit < 1 || limit > maxCursorLimit {
+		return 10
+	}
+	return limit
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/project"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeaturedHandler manages the featured slots (hero, sidebar, homepage grid)
+type FeaturedHandler struct {
+	featuredUsecase domain.FeaturedUsecase
+}
+
+// NewFeaturedHandler creates a new featured slots handler
+func NewFeaturedHandler(featuredUC domain.FeaturedUsecase) *FeaturedHandler {
+	return &FeaturedHandler{
+		featuredUsecase: featuredUC,
+	}
+}
+
+// FeaturedEntryResponse is a resolved slot entry; Data is the article,
+// project or course, shaped as in its own public endpoint
+type FeaturedEntryResponse struct {
+	ID          string      `json:"id"`
+	Position    int         `json:"position"`
+	ContentType string      `json:"contentType"`
+	ContentID   string      `json:"contentId"`
+	Data        interface{} `json:"data"`
+}
+
+// GET /api/public/featured?lang=
+// Resolves every slot, keyed by slot name.
+func (h *FeaturedHandler) ResolveSlots(c *gin.Context) {
+	lang, ok := readerLanguage(c)
+	if !ok {
+		return
+	}
+
+	slots, err := h.featuredUsecase.ResolveSlots(c.Request.Context(), lang.Language())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := make(map[string][]FeaturedEntryResponse, len(slots))
+	for slot, entries := range slots {
+		response[slot] = mapFeaturedEntries(entries)
+	}
+	c.JSON(http.StatusOK, gin.H{"slots": response})
+}
+
+// GET /api/public/featured/:slot?lang=
+// Returns what the slot shows now, in display order and up to its capacity.
+func (h *FeaturedHandler) ResolveSlot(c *gin.Context) {
+	lang, ok := readerLanguage(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.featuredUsecase.ResolveSlot(c.Request.Context(), c.Param("slot"), lang.Language())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"slot":    c.Param("slot"),
+		"entries": mapFeaturedEntries(entries),
+	})
+}
+
+// GET /api/admin/featured
+// Lists the entries of every slot, scheduled and expired ones included.
+func (h *FeaturedHandler) GetSlots(c *gin.Context) {
+	slots, err := h.featuredUsecase.GetSlots(c.Request.Context())
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"slots":    slots,
+		"capacity": domain.FeaturedSlotCapacity,
+	})
+}
+
+// GET /api/admin/featured/:slot
+func (h *FeaturedHandler) GetSlot(c *gin.Context) {
+	items, err := h.featuredUsecase.GetSlot(c.Request.Context(), c.Param("slot"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": items})
+}
+
+// POST /api/admin/featured/:slot
+// Adds an article, project or course to the slot, at the end unless a
+// position is given.
+func (h *FeaturedHandler) AddItem(c *gin.Context) {
+	var req domain.CreateFeaturedItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.featuredUsecase.AddItem(c.Request.Context(), c.Param("slot"), req, currentUserID(c))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"data": item})
+}
+
+// PUT /api/admin/featured/:slot/order
+// Body: {"ids": [...]} listing every entry of the slot in the new order.
+func (h *FeaturedHandler) ReorderSlot(c *gin.Context) {
+	var req domain.ReorderFeaturedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	items, err := h.featuredUsecase.ReorderSlot(c.Request.Context(), c.Param("slot"), req.IDs)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": items})
+}
+
+// PUT /api/admin/featured/:slot/:id
+// Replaces the entry's date window and, when given, its position.
+func (h *FeaturedHandler) UpdateItem(c *gin.Context) {
+	var req domain.UpdateFeaturedItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.featuredUsecase.UpdateItem(c.Request.Context(), c.Param("slot"), c.Param("id"), req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": item})
+}
+
+// DELETE /api/admin/featured/:slot/:id
+func (h *FeaturedHandler) RemoveItem(c *gin.Context) {
+	if err := h.featuredUsecase.RemoveItem(c.Request.Context(), c.Param("slot"), c.Param("id")); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Featured entry removed successfully"})
+}
+
+func (h *FeaturedHandler) respondError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrInvalidFeaturedSlot:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case domain.ErrInvalidFeaturedContentType, domain.ErrInvalidFeaturedWindow,
+		domain.ErrInvalidFeaturedPosition, domain.ErrInvalidFeaturedOrder:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case domain.ErrFeaturedItemNotFound, domain.ErrArticleNotFound, project.ErrProjectNotFound, course.ErrCourseNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case domain.ErrFeaturedItemExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func mapFeaturedEntries(entries []*domain.FeaturedEntry) []FeaturedEntryResponse {
+	responses := make([]FeaturedEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		response := FeaturedEntryResponse{
+			ID:          entry.Item.ID,
+			Position:    entry.Item.Position,
+			ContentType: entry.Item.ContentType,
+			ContentID:   entry.Item.ContentID,
+		}
+		switch {
+		case entry.Article != nil:
+			response.Data = mapArticleToResponse(entry.Article)
+		case entry.Project != nil:
+			response.Data = entry.Project
+		case entry.Course != nil:
+			response.Data = entry.Course
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
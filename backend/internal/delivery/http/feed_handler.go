@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeedHandler serves RSS, Atom and JSON feeds of published articles
+type FeedHandler struct {
+	feedUsecase domain.FeedUsecase
+}
+
+// NewFeedHandler creates a new feed handler
+func NewFeedHandler(feedUC domain.FeedUsecase) *FeedHandler {
+	return &FeedHandler{
+		feedUsecase: feedUC,
+	}
+}
+
+// GET /feed.xml, /categories/:category/feed.xml, /tags/:tag/feed.xml
+func (h *FeedHandler) GetRSSFeed(c *gin.Context) {
+	h.serveFeed(c, domain.FeedFormatRSS)
+}
+
+// GET /atom.xml, /categories/:category/atom.xml, /tags/:tag/atom.xml
+func (h *FeedHandler) GetAtomFeed(c *gin.Context) {
+	h.serveFeed(c, domain.FeedFormatAtom)
+}
+
+// GET /feed.json, /categories/:category/feed.json, /tags/:tag/feed.json
+func (h *FeedHandler) GetJSONFeed(c *gin.Context) {
+	h.serveFeed(c, domain.FeedFormatJSON)
+}
+
+func (h *FeedHandler) serveFeed(c *gin.Context, format string) {
+	filter := domain.FeedFilter{
+		Category: c.Param("category"),
+		Tag:      c.Param("tag"),
+	}
+
+	feed, err := h.feedUsecase.GetArticleFeed(c.Request.Context(), filter)
+	if err != nil {
+		switch err {
+		case domain.ErrCategoryNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+		case domain.ErrTagNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	selfURL := requestURL(c)
+
+	var body []byte
+	var contentType string
+	switch format {
+	case domain.FeedFormatAtom:
+		body, err = feed.Atom(selfURL)
+		contentType = "application/atom+xml; charset=utf-8"
+	case domain.FeedFormatJSON:
+		body, err = feed.JSON(selfURL)
+		contentType = "application/feed+json; charset=utf-8"
+	default:
+		body, err = feed.RSS(selfURL)
+		contentType = "application/rss+xml; charset=utf-8"
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeCacheable(c, contentType, body, feed.Updated)
+}
+
+// writeCacheable sends a generated document with an ETag and Last-Modified,
+// answering conditional requests that still match with 304 Not Modified
+func writeCacheable(c *gin.Context, contentType string, body []byte, lastModified time.Time) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=300")
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	// If-None-Match takes precedence over If-Modified-Since (RFC 9110)
+	if match := c.GetHeader("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				c.Status(http.StatusNotModified)
+				return
+			}
+		}
+	} else if since := c.GetHeader("If-Modified-Since"); since != "" && !lastModified.IsZero() {
+		if t, err := http.ParseTime(since); err == nil && !lastModified.Truncate(time.Second).After(t) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// requestURL rebuilds the absolute URL of the current request, honouring
+// the scheme and host set by a reverse proxy
+func requestURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+
+	host := c.Request.Host
+	if forwarded := c.GetHeader("X-Forwarded-Host"); forwarded != "" {
+		host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+
+	return scheme + "://" + host + c.Request.URL.Path
+}
@@ -81,8 +81,8 @@ func OptionalJWTAuthMiddleware() gin.HandlerFunc {
 	}
 }
 
-// RequireRole checks if the authenticated user has the required role
-func RequireRole(role string) gin.HandlerFunc {
+// RequireRole checks if the authenticated user has one of the given roles
+func RequireRole(roles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userRole, exists := c.Get("user_role")
 		if !exists {
@@ -93,7 +93,14 @@ func RequireRole(role string) gin.HandlerFunc {
 			return
 		}
 
-		if userRole != role {
+		allowed := false
+		for _, role := range roles {
+			if userRole == role {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
 			c.JSON(http.StatusForbidden, gin.H{
 				"error": "Insufficient permissions",
 			})
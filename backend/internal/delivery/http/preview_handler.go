@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PreviewHandler manages preview links for unpublished articles and courses
+type PreviewHandler struct {
+	previewUsecase domain.PreviewUsecase
+}
+
+// NewPreviewHandler creates a new preview handler
+func NewPreviewHandler(previewUC domain.PreviewUsecase) *PreviewHandler {
+	return &PreviewHandler{
+		previewUsecase: previewUC,
+	}
+}
+
+// previewContentTypes maps the collection names used in admin routes to content types
+var previewContentTypes = map[string]string{
+	"articles": domain.PreviewContentArticle,
+	"courses":  domain.PreviewContentCourse,
+}
+
+// GET /api/public/preview/:token?format=html|markdown|text
+// Shows the draft behind a preview link the way its public page will, with
+// view tracking off and no caching or indexing.
+func (h *PreviewHandler) GetPreview(c *gin.Context) {
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+
+	preview, err := h.previewUsecase.GetPreview(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	response := gin.H{
+		"type":      preview.Token.ContentType,
+		"expiresAt": preview.Token.ExpiresAt,
+	}
+
+	switch {
+	case preview.Article != nil:
+		format := c.DefaultQuery("format", domain.ContentFormatEditorJS)
+		content, err := domain.RenderContent(preview.Article.Content, format)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		article := mapArticleToResponse(preview.Article)
+		article.Content = content
+		article.Format = format
+		response["data"] = article
+	case preview.Course != nil:
+		response["data"] = preview.Course
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GET /api/admin/previews/:type/:id
+// Lists the preview links of an article or course, expired and revoked included
+func (h *PreviewHandler) GetTokens(c *gin.Context) {
+	tokens, err := h.previewUsecase.GetTokens(c.Request.Context(), previewContentTypes[c.Param("type")], c.Param("id"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": tokens})
+}
+
+// POST /api/admin/previews/:type/:id
+// The token in the response is not stored and cannot be shown again.
+func (h *PreviewHandler) CreateToken(c *gin.Context) {
+	var req domain.CreatePreviewTokenRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	token, err := h.previewUsecase.CreateToken(c.Request.Context(), previewContentTypes[c.Param("type")], c.Param("id"), req, currentUserID(c))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": token,
+		"path": "/api/public/preview/" + token.Token,
+	})
+}
+
+// DELETE /api/admin/previews/:id
+func (h *PreviewHandler) RevokeToken(c *gin.Context) {
+	if err := h.previewUsecase.RevokeToken(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Preview link revoked successfully"})
+}
+
+func (h *PreviewHandler) respondError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrInvalidPreviewContentType, domain.ErrInvalidPreviewTTL:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case domain.ErrPreviewTokenNotFound, domain.ErrArticleNotFound, course.ErrCourseNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case domain.ErrPreviewTokenExpired:
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
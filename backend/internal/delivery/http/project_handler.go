@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"portfolio/internal/domain/bulk"
 	"portfolio/internal/domain/project"
 	"portfolio/internal/infrastructure/logger"
 	"portfolio/internal/usecase"
@@ -25,12 +26,24 @@ func NewProjectHandler(projectUseCase *usecase.ProjectUseCase, logger logger.Log
 	}
 }
 
-// GetAllProjects handles GET /public/projects
+// GetAllProjects handles GET /public/projects, which lists published
+// projects only. With ?cursor= (empty for the first page) and ?limit= it
+// returns a keyset page with nextCursor instead of every project.
 func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
+	h.listProjects(c, "published")
+}
+
+// GetAdminProjects handles GET /admin/projects, which lists drafts too and
+// takes an optional ?status= filter
+func (h *ProjectHandler) GetAdminProjects(c *gin.Context) {
+	h.listProjects(c, c.Query("status"))
+}
+
+func (h *ProjectHandler) listProjects(c *gin.Context, status string) {
 	filters := make(map[string]interface{})
 
 	// Optional filters
-	if status := c.Query("status"); status != "" {
+	if status != "" {
 		filters["status"] = status
 	}
 	if category := c.Query("category"); category != "" {
@@ -40,6 +53,22 @@ func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
 		filters["featured"] = featured
 	}
 
+	cursor, ok := cursorParam(c)
+	if !ok {
+		return
+	}
+	if cursor != nil {
+		limit, _ := strconv.Atoi(c.Query("limit"))
+		page, err := h.projectUseCase.ListProjectsPage(c.Request.Context(), filters, *cursor, cursorLimit(limit))
+		if err != nil {
+			h.logger.Error("Failed to get projects", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch projects"})
+			return
+		}
+		c.JSON(http.StatusOK, page)
+		return
+	}
+
 	projects, err := h.projectUseCase.ListProjects(c.Request.Context(), filters)
 	if err != nil {
 		h.logger.Error("Failed to get projects", err)
@@ -50,12 +79,15 @@ func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
 	c.JSON(http.StatusOK, projects)
 }
 
-// GetProjectBySlug handles GET /public/projects/:slug
+// GetProjectBySlug handles GET /public/projects/:slug; old slugs answer with a 301
 func (h *ProjectHandler) GetProjectBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 
 	project, err := h.projectUseCase.GetProjectBySlug(c.Request.Context(), slug)
 	if err != nil {
+		if respondSlugRedirect(c, err) {
+			return
+		}
 		h.logger.Error("Failed to get project by slug", err)
 		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
 		return
@@ -76,6 +108,9 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	proj, err := h.projectUseCase.CreateProject(c.Request.Context(), req)
 	if err != nil {
 		h.logger.Error("Failed to create project", err)
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create project"})
 		return
 	}
@@ -96,6 +131,9 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 
 	if err := h.projectUseCase.UpdateProject(c.Request.Context(), id, req); err != nil {
 		h.logger.Error("Failed to update project", err)
+		if respondSlugConflict(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update project"})
 		return
 	}
@@ -116,6 +154,20 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Project deleted successfully"})
 }
 
+// BulkProjects handles POST /admin/projects/bulk
+// Applies publish, unpublish, feature, unfeature, change_category, add_tag,
+// remove_tag or delete to many projects in one transaction.
+func (h *ProjectHandler) BulkProjects(c *gin.Context) {
+	var req bulk.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.projectUseCase.BulkProjects(c.Request.Context(), req)
+	respondBulk(c, report, err)
+}
+
 // GetRecentProjects handles GET /public/projects/recent
 // Returns featured and recent projects sorted by display order and date
 func (h *ProjectHandler) GetRecentProjects(c *gin.Context) {
@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"portfolio/internal/delivery/http/middleware"
+	"portfolio/internal/domain"
 	"portfolio/internal/domain/user"
 	"portfolio/internal/infrastructure/logger"
 	"portfolio/internal/usecase"
@@ -23,6 +24,17 @@ func NewRouter(
 	courseHandler *CourseHandler,
 	projectHandler *ProjectHandler,
 	articleHandler *ArticleHandler,
+	tagHandler *TagHandler,
+	seriesHandler *SeriesHandler,
+	commentHandler *CommentHandler,
+	analyticsHandler *AnalyticsHandler,
+	feedHandler *FeedHandler,
+	sitemapHandler *SitemapHandler,
+	seoHandler *SEOHandler,
+	articleMarkdownHandler *ArticleMarkdownHandler,
+	previewHandler *PreviewHandler,
+	authorHandler *AuthorHandler,
+	featuredHandler *FeaturedHandler,
 	logger logger.Logger,
 	db *sql.DB,
 ) *gin.Engine {
@@ -33,7 +45,7 @@ func NewRouter(
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
 	config.AllowCredentials = true
-	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Visitor-ID", "X-Referrer"}
 	router.Use(cors.New(config))
 
 	// Health check
@@ -47,6 +59,22 @@ func NewRouter(
 	// Prometheus metrics
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
+	// Syndication feeds of published articles
+	router.GET("/feed.xml", feedHandler.GetRSSFeed)
+	router.GET("/atom.xml", feedHandler.GetAtomFeed)
+	router.GET("/feed.json", feedHandler.GetJSONFeed)
+	router.GET("/categories/:category/feed.xml", feedHandler.GetRSSFeed)
+	router.GET("/categories/:category/atom.xml", feedHandler.GetAtomFeed)
+	router.GET("/categories/:category/feed.json", feedHandler.GetJSONFeed)
+	router.GET("/tags/:tag/feed.xml", feedHandler.GetRSSFeed)
+	router.GET("/tags/:tag/atom.xml", feedHandler.GetAtomFeed)
+	router.GET("/tags/:tag/feed.json", feedHandler.GetJSONFeed)
+
+	// Sitemaps and robots.txt, generated from live content
+	router.GET("/robots.txt", sitemapHandler.GetRobotsTxt)
+	router.GET("/sitemap.xml", sitemapHandler.GetSitemapIndex)
+	router.GET("/sitemaps/:file", sitemapHandler.GetSitemap)
+
 	// API routes
 	api := router.Group("/api")
 	{
@@ -67,7 +95,25 @@ func NewRouter(
 			public.GET("/articles/featured", articleHandler.GetFeaturedArticle)
 			public.GET("/articles/featured-list", articleHandler.GetFeaturedArticles)
 			public.GET("/articles/search", articleHandler.SearchArticles)
-			public.GET("/articles/:slug", articleHandler.GetArticle)
+			public.GET("/articles/:slug", middleware.OptionalJWTAuthMiddleware(), articleHandler.GetArticle)
+			public.GET("/articles/:slug/related", articleHandler.GetRelatedArticles)
+			public.GET("/articles/:slug/comments", commentHandler.GetArticleComments)
+			public.GET("/articles/:slug/like", middleware.OptionalJWTAuthMiddleware(), articleHandler.GetArticleLike)
+			public.POST("/articles/:slug/like", middleware.OptionalJWTAuthMiddleware(), articleHandler.ToggleArticleLike)
+
+			// Tag routes
+			public.GET("/tags", tagHandler.GetPublicTags)
+			public.GET("/tags/:slug/articles", tagHandler.GetTagArticles)
+
+			// Author pages
+			public.GET("/authors/:slug", authorHandler.GetAuthorPage)
+
+			// Series routes
+			public.GET("/series", seriesHandler.GetPublicSeriesList)
+			public.GET("/series/:slug", seriesHandler.GetPublicSeries)
+
+			// SEO metadata for server-side rendering and link unfurlers
+			public.GET("/seo", seoHandler.GetMetadata)
 
 			// Categories (public for form access)
 			public.GET("/categories", articleHandler.GetCategories)
@@ -75,6 +121,13 @@ func NewRouter(
 			// Course routes (public)
 			public.GET("/courses", courseHandler.GetCourses)
 			public.GET("/courses/:slug", courseHandler.GetCourse)
+
+			// Preview links for unpublished articles and courses
+			public.GET("/preview/:token", previewHandler.GetPreview)
+
+			// Featured slots (hero, sidebar, homepage grid)
+			public.GET("/featured", featuredHandler.ResolveSlots)
+			public.GET("/featured/:slot", featuredHandler.ResolveSlot)
 		}
 
 		// Auth routes
@@ -149,19 +202,34 @@ func NewRouter(
 			})
 		}
 
-		// Admin endpoints (protected with JWT)
-		admin := api.Group("/admin")
-		admin.Use(middleware.JWTAuthMiddleware())
+		// Writer endpoints (protected with JWT): drafting and submitting
+		// articles, and the writer's own author profile. Which articles a
+		// writer may change is checked by the article usecase.
+		writer := api.Group("/admin")
+		writer.Use(middleware.JWTAuthMiddleware(), middleware.RequireRole(domain.RoleAdmin, domain.RoleEditor, domain.RoleWriter))
 		{
-			// Article management
-			admin.GET("/articles", articleHandler.GetArticles)
-			admin.GET("/articles/:id", articleHandler.GetArticleByID)
-			admin.POST("/articles", articleHandler.CreateArticle)
-			admin.PUT("/articles/:id", articleHandler.UpdateArticle)
-			admin.DELETE("/articles/:id", articleHandler.DeleteArticle)
+			// Article drafting
+			writer.GET("/articles", articleHandler.GetArticles)
+			writer.GET("/articles/:id", articleHandler.GetArticleByID)
+			writer.POST("/articles", articleHandler.CreateArticle)
+			writer.POST("/articles/lint", articleHandler.LintArticle)
+			writer.PUT("/articles/:id", articleHandler.UpdateArticle)
+			writer.GET("/articles/:id/revisions", articleHandler.GetArticleRevisions)
+			writer.GET("/articles/:id/revisions/diff", articleHandler.DiffArticleRevisions)
+			writer.GET("/articles/:id/revisions/:revisionId", articleHandler.GetArticleRevision)
+			writer.POST("/articles/:id/revisions/:revisionId/restore", articleHandler.RestoreArticleRevision)
+			writer.GET("/articles/:id/transitions", articleHandler.GetArticleTransitions)
+			writer.POST("/articles/:id/transitions", articleHandler.TransitionArticle)
+			writer.GET("/articles/:id/translations", articleHandler.GetArticleTranslations)
+			writer.PUT("/articles/:id/translations/:lang", articleHandler.SaveArticleTranslation)
+			writer.DELETE("/articles/:id/translations/:lang", articleHandler.DeleteArticleTranslation)
+
+			// Own author profile
+			writer.GET("/authors/me", authorHandler.GetMyProfile)
+			writer.PUT("/authors/:id", authorHandler.UpdateProfile)
 
 			// User management
-			admin.PUT("/change-password", func(c *gin.Context) {
+			writer.PUT("/change-password", func(c *gin.Context) {
 				var req struct {
 					CurrentPassword string `json:"current_password" binding:"required"`
 					NewPassword     string `json:"new_password" binding:"required"`
@@ -206,6 +274,24 @@ func NewRouter(
 				logger.Info(fmt.Sprintf("Password changed successfully for user ID: %s", userID))
 				c.JSON(200, gin.H{"message": "Password changed successfully"})
 			})
+		}
+
+		// Admin endpoints (protected with JWT). Customers, students and
+		// writers also hold tokens, so only editors and admins get in.
+		admin := api.Group("/admin")
+		admin.Use(middleware.JWTAuthMiddleware(), middleware.RequireRole(domain.RoleAdmin, domain.RoleEditor))
+		{
+			// Article management
+			admin.POST("/articles/import", articleMarkdownHandler.ImportArticles)
+			admin.GET("/articles/export", articleMarkdownHandler.ExportArticles)
+			admin.POST("/articles/bulk", articleHandler.BulkArticles)
+			admin.DELETE("/articles/:id", articleHandler.DeleteArticle)
+			admin.GET("/articles/:id/related", articleHandler.GetRelatedPins)
+			admin.PUT("/articles/:id/related", articleHandler.SetRelatedPins)
+			admin.PUT("/articles/:id/author", middleware.RequireRole("admin"), authorHandler.ReassignArticle)
+
+			// Author profiles
+			admin.GET("/authors", authorHandler.GetAuthors)
 
 			// Homepage management
 			admin.PUT("/homepage", homepageHandler.UpdateContent)
@@ -214,13 +300,54 @@ func NewRouter(
 			admin.DELETE("/tech-stacks/:id", homepageHandler.DeleteTechStack)
 
 			// Project management
+			admin.GET("/projects", projectHandler.GetAdminProjects)
 			admin.POST("/projects", projectHandler.CreateProject)
 			admin.PUT("/projects/:id", projectHandler.UpdateProject)
 			admin.DELETE("/projects/:id", projectHandler.DeleteProject)
+			admin.POST("/projects/bulk", projectHandler.BulkProjects)
 
 			// Categories
 			admin.GET("/categories", articleHandler.GetCategories)
 
+			// Tag management
+			admin.GET("/tags", tagHandler.GetTags)
+			admin.POST("/tags/merge", tagHandler.MergeTags)
+			admin.PUT("/tags/:id", tagHandler.UpdateTag)
+			admin.DELETE("/tags/:id", tagHandler.DeleteTag)
+
+			// Series management
+			admin.GET("/series", seriesHandler.GetSeriesList)
+			admin.GET("/series/:id", seriesHandler.GetSeries)
+			admin.POST("/series", seriesHandler.CreateSeries)
+			admin.PUT("/series/:id", seriesHandler.UpdateSeries)
+			admin.DELETE("/series/:id", seriesHandler.DeleteSeries)
+
+			// Comment moderation
+			admin.GET("/comments", commentHandler.ListComments)
+			admin.PUT("/comments/:id/status", commentHandler.ModerateComment)
+			admin.DELETE("/comments/:id", commentHandler.DeleteComment)
+
+			// SEO overrides
+			admin.GET("/seo/:type/:id", seoHandler.GetOverride)
+			admin.PUT("/seo/:type/:id", seoHandler.SetOverride)
+			admin.DELETE("/seo/:type/:id", seoHandler.DeleteOverride)
+
+			// Preview links
+			admin.GET("/previews/:type/:id", previewHandler.GetTokens)
+			admin.POST("/previews/:type/:id", previewHandler.CreateToken)
+			admin.DELETE("/previews/:id", previewHandler.RevokeToken)
+
+			// Featured slots
+			admin.GET("/featured", featuredHandler.GetSlots)
+			admin.GET("/featured/:slot", featuredHandler.GetSlot)
+			admin.POST("/featured/:slot", featuredHandler.AddItem)
+			admin.PUT("/featured/:slot/order", featuredHandler.ReorderSlot)
+			admin.PUT("/featured/:slot/:id", featuredHandler.UpdateItem)
+			admin.DELETE("/featured/:slot/:id", featuredHandler.RemoveItem)
+
+			// Analytics
+			admin.GET("/analytics/articles", analyticsHandler.GetArticleAnalytics)
+
 			// Newsletter
 			admin.GET("/newsletter/subscribers", articleHandler.GetSubscribers)
 
@@ -230,6 +357,7 @@ func NewRouter(
 			admin.POST("/courses", courseHandler.CreateCourse)
 			admin.PUT("/courses/:id", courseHandler.UpdateCourse)
 			admin.DELETE("/courses/:id", courseHandler.DeleteCourse)
+			admin.POST("/courses/bulk", courseHandler.BulkCourses)
 			admin.GET("/courses/:id/curriculum", courseHandler.GetCourseCurriculum)
 
 			// Section management
@@ -248,6 +376,14 @@ func NewRouter(
 			admin.GET("/upload/images", articleHandler.ListImages)
 		}
 
+		// Customer endpoints (protected with JWT)
+		customer := api.Group("/customer")
+		customer.Use(middleware.JWTAuthMiddleware())
+		{
+			// Comments
+			customer.POST("/articles/:slug/comments", commentHandler.CreateComment)
+		}
+
 		// Student endpoints (protected with JWT)
 		student := api.Group("/student")
 		student.Use(middleware.JWTAuthMiddleware())
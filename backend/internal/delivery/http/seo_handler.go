@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/project"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SEOHandler serves page metadata for crawlers and link unfurlers
+type SEOHandler struct {
+	seoUsecase domain.SEOUsecase
+}
+
+// NewSEOHandler creates a new SEO handler
+func NewSEOHandler(seoUC domain.SEOUsecase) *SEOHandler {
+	return &SEOHandler{
+		seoUsecase: seoUC,
+	}
+}
+
+// seoContentTypes maps the collection names used in admin routes to content types
+var seoContentTypes = map[string]string{
+	"articles": domain.SEOContentArticle,
+	"projects": domain.SEOContentProject,
+	"courses":  domain.SEOContentCourse,
+}
+
+// GET /api/public/seo?path=/articles/:slug
+// Also accepts /projects/:slug, /courses/:slug and the static pages.
+func (h *SEOHandler) GetMetadata(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "path is required"})
+		return
+	}
+
+	meta, err := h.seoUsecase.GetMetadata(c.Request.Context(), path)
+	if err != nil {
+		if err == domain.ErrSEOPageNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, meta)
+}
+
+// GET /api/admin/seo/:type/:id
+func (h *SEOHandler) GetOverride(c *gin.Context) {
+	override, err := h.seoUsecase.GetOverride(c.Request.Context(), seoContentTypes[c.Param("type")], c.Param("id"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, override)
+}
+
+// PUT /api/admin/seo/:type/:id
+// Replaces the override; empty fields fall back to the generated values.
+func (h *SEOHandler) SetOverride(c *gin.Context) {
+	var req domain.SEOOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	override, err := h.seoUsecase.SetOverride(c.Request.Context(), seoContentTypes[c.Param("type")], c.Param("id"), req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, override)
+}
+
+// DELETE /api/admin/seo/:type/:id
+func (h *SEOHandler) DeleteOverride(c *gin.Context) {
+	err := h.seoUsecase.DeleteOverride(c.Request.Context(), seoContentTypes[c.Param("type")], c.Param("id"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "SEO override deleted successfully"})
+}
+
+func (h *SEOHandler) respondError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrInvalidSEOContentType, domain.ErrInvalidSEOCanonicalURL:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case domain.ErrSEOOverrideNotFound, domain.ErrArticleNotFound, project.ErrProjectNotFound, course.ErrCourseNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
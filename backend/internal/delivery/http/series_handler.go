@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SeriesHandler handles multi-part article series
+type SeriesHandler struct {
+	seriesUsecase domain.SeriesUsecase
+}
+
+// NewSeriesHandler creates a new series handler
+func NewSeriesHandler(seriesUC domain.SeriesUsecase) *SeriesHandler {
+	return &SeriesHandler{
+		seriesUsecase: seriesUC,
+	}
+}
+
+type SeriesResponse struct {
+	ID           string            `json:"id"`
+	Title        string            `json:"title"`
+	Slug         string            `json:"slug"`
+	Description  string            `json:"description"`
+	ArticleCount int               `json:"articleCount"`
+	Articles     []ArticleResponse `json:"articles,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+}
+
+// GET /api/public/series
+func (h *SeriesHandler) GetPublicSeriesList(c *gin.Context) {
+	series, err := h.seriesUsecase.GetSeriesList(c.Request.Context(), true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"series": mapSeriesListToResponse(series)})
+}
+
+// GET /api/public/series/:slug
+// Lists the published parts in order.
+func (h *SeriesHandler) GetPublicSeries(c *gin.Context) {
+	series, err := h.seriesUsecase.GetPublicSeries(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapSeriesToResponse(series))
+}
+
+// GET /api/admin/series
+func (h *SeriesHandler) GetSeriesList(c *gin.Context) {
+	series, err := h.seriesUsecase.GetSeriesList(c.Request.Context(), false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"series": mapSeriesListToResponse(series)})
+}
+
+// GET /api/admin/series/:id
+func (h *SeriesHandler) GetSeries(c *gin.Context) {
+	series, err := h.seriesUsecase.GetSeriesByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapSeriesToResponse(series))
+}
+
+// POST /api/admin/series
+func (h *SeriesHandler) CreateSeries(c *gin.Context) {
+	var req domain.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series, err := h.seriesUsecase.CreateSeries(c.Request.Context(), req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapSeriesToResponse(series))
+}
+
+// PUT /api/admin/series/:id
+func (h *SeriesHandler) UpdateSeries(c *gin.Context) {
+	var req domain.UpdateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series, err := h.seriesUsecase.UpdateSeries(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapSeriesToResponse(series))
+}
+
+// DELETE /api/admin/series/:id
+func (h *SeriesHandler) DeleteSeries(c *gin.Context) {
+	if err := h.seriesUsecase.DeleteSeries(c.Request.Context(), c.Param("id")); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Series deleted successfully"})
+}
+
+func (h *SeriesHandler) respondError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrSeriesNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Series not found"})
+	case domain.ErrSlugAlreadyExists, domain.ErrArticleInAnotherSeries:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case domain.ErrInvalidSeriesArticles, domain.ErrInvalidSeriesTitle:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func mapSeriesToResponse(series *domain.Series) SeriesResponse {
+	response := SeriesResponse{
+		ID:           series.ID,
+		Title:        series.Title,
+		Slug:         series.Slug,
+		Description:  series.Description,
+		ArticleCount: series.ArticleCount,
+		CreatedAt:    series.CreatedAt,
+		UpdatedAt:    series.UpdatedAt,
+	}
+
+	if series.Articles != nil {
+		response.Articles = mapArticlesToResponse(series.Articles)
+		for i := range response.Articles {
+			response.Articles[i].Content = ""
+		}
+	}
+
+	return response
+}
+
+func mapSeriesListToResponse(series []*domain.Series) []SeriesResponse {
+	responses := make([]SeriesResponse, len(series))
+	for i, s := range series {
+		responses[i] = mapSeriesToResponse(s)
+	}
+	return responses
+}
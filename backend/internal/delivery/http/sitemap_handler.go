@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SitemapHandler serves the sitemap index, its sitemaps and robots.txt
+type SitemapHandler struct {
+	sitemapUsecase domain.SitemapUsecase
+}
+
+// NewSitemapHandler creates a new sitemap handler
+func NewSitemapHandler(sitemapUC domain.SitemapUsecase) *SitemapHandler {
+	return &SitemapHandler{
+		sitemapUsecase: sitemapUC,
+	}
+}
+
+// GET /sitemap.xml
+// Sitemap index listing one file per section, split at the protocol limits.
+func (h *SitemapHandler) GetSitemapIndex(c *gin.Context) {
+	files, err := h.sitemapUsecase.GetSitemapIndex(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, err := domain.EncodeSitemapIndex(files)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var lastModified time.Time
+	for _, file := range files {
+		if file.LastMod.After(lastModified) {
+			lastModified = file.LastMod
+		}
+	}
+
+	writeCacheable(c, "application/xml; charset=utf-8", body, lastModified)
+}
+
+// GET /sitemaps/:file
+// Files are named <section>-<page>.xml, e.g. articles-1.xml.
+func (h *SitemapHandler) GetSitemap(c *gin.Context) {
+	section, page, ok := parseSitemapFile(c.Param("file"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap not found"})
+		return
+	}
+
+	urls, err := h.sitemapUsecase.GetSitemap(c.Request.Context(), section, page)
+	if err != nil {
+		if err == domain.ErrSitemapNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, err := domain.EncodeSitemap(urls)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var lastModified time.Time
+	for _, u := range urls {
+		if u.LastMod.After(lastModified) {
+			lastModified = u.LastMod
+		}
+	}
+
+	writeCacheable(c, "application/xml; charset=utf-8", body, lastModified)
+}
+
+// GET /robots.txt
+func (h *SitemapHandler) GetRobotsTxt(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.String(http.StatusOK, h.sitemapUsecase.GetRobotsTxt())
+}
+
+func parseSitemapFile(file string) (string, int, bool) {
+	name, ok := strings.CutSuffix(file, ".xml")
+	if !ok {
+		return "", 0, false
+	}
+
+	dash := strings.LastIndex(name, "-")
+	if dash < 1 {
+		return "", 0, false
+	}
+
+	page, err := strconv.Atoi(name[dash+1:])
+	if err != nil {
+		return "", 0, false
+	}
+
+	return name[:dash], page, true
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondSlugRedirect answers a lookup by an old slug with a 301 whose
+// Location is the same request under the current slug, and a body naming
+// the new slug so clients can update their own URLs. It reports whether
+// err was a redirect.
+func respondSlugRedirect(c *gin.Context, err error) bool {
+	var redirect *domain.SlugRedirect
+	if !errors.As(err, &redirect) {
+		return false
+	}
+
+	location := url.URL{
+		Path:     path.Join(path.Dir(c.Request.URL.Path), redirect.Slug),
+		RawQuery: c.Request.URL.RawQuery,
+	}
+	c.Header("Location", location.String())
+	c.JSON(http.StatusMovedPermanently, gin.H{
+		"error":    "Moved permanently",
+		"redirect": redirect,
+		"location": location.String(),
+	})
+	return true
+}
+
+// respondSlugConflict answers a save that lost a slug to a concurrent save
+// with 409, and reports whether err was such a conflict
+func respondSlugConflict(c *gin.Context, err error) bool {
+	if !errors.Is(err, domain.ErrSlugAlreadyExists) {
+		return false
+	}
+
+	c.JSON(http.StatusConflict, gin.H{"error": domain.ErrSlugAlreadyExists.Error()})
+	return true
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"portfolio/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TagHandler handles tag listing, tag pages and tag maintenance
+type TagHandler struct {
+	tagUsecase domain.TagUsecase
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tagUC domain.TagUsecase) *TagHandler {
+	return &TagHandler{
+		tagUsecase: tagUC,
+	}
+}
+
+type TagArticlesResponse struct {
+	Tag *domain.Tag `json:"tag"`
+	ArticleListResponse
+}
+
+// GET /api/public/tags
+// Only tags used by published articles are listed, most used first.
+func (h *TagHandler) GetPublicTags(c *gin.Context) {
+	tags, err := h.tagUsecase.GetTags(c.Request.Context(), true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+// GET /api/admin/tags
+func (h *TagHandler) GetTags(c *gin.Context) {
+	tags, err := h.tagUsecase.GetTags(c.Request.Context(), false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+// GET /api/public/tags/:slug/articles
+func (h *TagHandler) GetTagArticles(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	tag, result, err := h.tagUsecase.GetTagArticles(c.Request.Context(), c.Param("slug"), page, limit)
+	if err != nil {
+		if err == domain.ErrTagNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, TagArticlesResponse{
+		Tag: tag,
+		ArticleListResponse: ArticleListResponse{
+			Articles:   mapArticlesToResponse(result.Articles),
+			Total:      result.Total,
+			Page:       result.Page,
+			TotalPages: result.TotalPages,
+		},
+	})
+}
+
+// PUT /api/admin/tags/:id
+// Renames a tag and/or sets its description.
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	var req domain.UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := h.tagUsecase.UpdateTag(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		switch err {
+		case domain.ErrTagNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		case domain.ErrTagAlreadyExists:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case domain.ErrInvalidTagName:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// POST /api/admin/tags/merge
+func (h *TagHandler) MergeTags(c *gin.Context) {
+	var req domain.MergeTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := h.tagUsecase.MergeTags(c.Request.Context(), req)
+	if err != nil {
+		switch err {
+		case domain.ErrTagNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		case domain.ErrInvalidTagMerge:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// DELETE /api/admin/tags/:id
+// Removes the tag from every article.
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	err := h.tagUsecase.DeleteTag(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if err == domain.ErrTagNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag deleted successfully"})
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DirectReferrer is the referrer domain recorded for views without a referrer
+const DirectReferrer = "direct"
+
+// Analytics range limits
+const (
+	DefaultAnalyticsDays = 30
+	MaxAnalyticsDays     = 366
+	DefaultAnalyticsTopN = 10
+	MaxAnalyticsTopN     = 100
+)
+
+var ErrInvalidAnalyticsRange = errors.New("analytics range must have from before to and span at most 366 days")
+
+// ArticleAnalyticsParams selects the days to report on, both ends inclusive.
+// ArticleID narrows every section of the report to one article.
+type ArticleAnalyticsParams struct {
+	From      time.Time
+	To        time.Time
+	ArticleID string
+	Limit     int
+}
+
+// DailyTraffic is one day of views and likes. Likes are net of unlikes made
+// that day, so a day can go negative.
+type DailyTraffic struct {
+	Day   string `json:"day" db:"day"`
+	Views int    `json:"views" db:"views"`
+	Likes int    `json:"likes" db:"likes"`
+}
+
+type ArticleTraffic struct {
+	ArticleID    string `json:"articleId" db:"article_id"`
+	Title        string `json:"title" db:"title"`
+	Slug         string `json:"slug" db:"slug"`
+	CategorySlug string `json:"categorySlug" db:"category_slug"`
+	Views        int    `json:"views" db:"views"`
+	Likes        int    `json:"likes" db:"likes"`
+}
+
+type CategoryTraffic struct {
+	CategoryID   string `json:"categoryId" db:"category_id"`
+	Name         string `json:"name" db:"name"`
+	Slug         string `json:"slug" db:"slug"`
+	ArticleCount int    `json:"articleCount" db:"article_count"` // articles with traffic in the range
+	Views        int    `json:"views" db:"views"`
+	Likes        int    `json:"likes" db:"likes"`
+}
+
+type ReferrerTraffic struct {
+	Domain string `json:"domain" db:"domain"`
+	Views  int    `json:"views" db:"views"`
+}
+
+// ArticleAnalytics is the dashboard report for a date range
+type ArticleAnalytics struct {
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	Views       int               `json:"views"`
+	Likes       int               `json:"likes"`
+	TopArticles []ArticleTraffic  `json:"topArticles"`
+	Daily       []DailyTraffic    `json:"daily"` // one entry per day, oldest first
+	Categories  []CategoryTraffic `json:"categories"`
+	Referrers   []ReferrerTraffic `json:"referrers"`
+}
+
+// AnalyticsRepository reads the daily rollups kept by ArticleRepository.RecordView
+// and ToggleLike
+type AnalyticsRepository interface {
+	GetDailyTraffic(ctx context.Context, params ArticleAnalyticsParams) ([]DailyTraffic, error)
+	GetTopArticles(ctx context.Context, params ArticleAnalyticsParams) ([]ArticleTraffic, error)
+	GetCategoryTraffic(ctx context.Context, params ArticleAnalyticsParams) ([]CategoryTraffic, error)
+	GetTopReferrers(ctx context.Context, params ArticleAnalyticsParams) ([]ReferrerTraffic, error)
+}
+
+type AnalyticsUsecase interface {
+	GetArticleAnalytics(ctx context.Context, params ArticleAnalyticsParams) (*ArticleAnalytics, error)
+}
+
+// ReferrerDomain reduces a referrer URL to its host without a leading "www.",
+// or DirectReferrer when there is none
+func ReferrerDomain(referrer string) string {
+	referrer = strings.TrimSpace(referrer)
+	if referrer == "" {
+		return DirectReferrer
+	}
+	if !strings.Contains(referrer, "://") {
+		referrer = "https://" + referrer
+	}
+
+	parsed, err := url.Parse(referrer)
+	if err != nil || parsed.Hostname() == "" {
+		return DirectReferrer
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if len(host) > 255 {
+		host = host[:255]
+	}
+	return host
+}
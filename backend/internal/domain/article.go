@@ -6,6 +6,11 @@ import (
 	"regexp"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"portfolio/internal/domain/bulk"
+	"portfolio/internal/domain/pagination"
 )
 
 // Article domain entity
@@ -14,18 +19,39 @@ type Article struct {
 	Title       string    `json:"title"`
 	Excerpt     string    `json:"excerpt"`
 	Content     string    `json:"content"`
+	ContentText string    `json:"-"` // plain-text rendering of Content, used for search
+	Language    string    `json:"language"`
 	Thumbnail   string    `json:"thumbnail"`
 	Category    Category  `json:"category"`
 	PublishedAt time.Time `json:"publishedAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
-	ReadTime    int       `json:"readTime"` // in minutes
-	Slug        string    `json:"slug"`
-	Featured    bool      `json:"featured"`
-	Published   bool      `json:"published"`
-	Author      Author    `json:"author"`
-	Tags        []string  `json:"tags"`
-	ViewCount   int       `json:"viewCount"`
-	LikeCount   int       `json:"likeCount"`
+	// Last change to the title, excerpt, content or thumbnail; what feeds,
+	// sitemaps and SEO metadata publish as the modification time
+	ContentUpdatedAt time.Time  `json:"contentUpdatedAt"`
+	ReadTime         int        `json:"readTime"` // in minutes
+	Slug             string     `json:"slug"`
+	Featured         bool       `json:"featured"`
+	Published        bool       `json:"published"`
+	Status           string     `json:"status"`                // workflow state, see ArticleStatusDraft
+	PublishAt        *time.Time `json:"publishAt,omitempty"`   // scheduled go-live time
+	UnpublishAt      *time.Time `json:"unpublishAt,omitempty"` // scheduled take-down time
+	Author           Author     `json:"author"`
+	Tags             []string   `json:"tags"`
+	ViewCount        int        `json:"viewCount"`
+	LikeCount        int        `json:"likeCount"`
+
+	Series *SeriesNavigation `json:"series,omitempty"` // set on single-article reads
+
+	// Set on public reads in another language than the source
+	SourceLanguage string            `json:"sourceLanguage,omitempty"`
+	Translations   []TranslationLink `json:"translations,omitempty"` // set on single-article reads
+
+	// Set by CreateArticle, and by UpdateArticle when the content is checked
+	Lint *LintReport `json:"lint,omitempty"`
+
+	// Set by full-text search only
+	SearchRank     float64 `json:"searchRank,omitempty"`
+	SearchHeadline string  `json:"searchHeadline,omitempty"` // HTML snippet with <mark> highlights
 }
 
 type Category struct {
@@ -42,6 +68,7 @@ type Author struct {
 	Email  string `json:"email"`
 	Avatar string `json:"avatar"`
 	Bio    string `json:"bio"`
+	Slug   string `json:"slug,omitempty"` // author page, empty until a profile exists
 }
 
 // Newsletter domain entity
@@ -63,11 +90,25 @@ type ArticleStats struct {
 
 // Request/Response DTOs
 type ArticleListParams struct {
-	Page      int    `json:"page"`
-	Limit     int    `json:"limit"`
-	Category  string `json:"category,omitempty"`
-	Featured  *bool  `json:"featured,omitempty"`
-	Published *bool  `json:"published,omitempty"`
+	Page        int        `json:"page"`
+	Limit       int        `json:"limit"`
+	Category    string     `json:"category,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`     // tag slugs
+	TagMatch    string     `json:"tagMatch,omitempty"` // TagMatchAny (default) or TagMatchAll
+	AuthorID    string     `json:"authorId,omitempty"`
+	Author      string     `json:"author,omitempty"` // author page slug
+	Status      string     `json:"status,omitempty"` // workflow state
+	Featured    *bool      `json:"featured,omitempty"`
+	Published   *bool      `json:"published,omitempty"`
+	From        *time.Time `json:"from,omitempty"` // published on or after
+	To          *time.Time `json:"to,omitempty"`   // published before
+	MinReadTime int        `json:"minReadTime,omitempty"`
+	Sort        string     `json:"sort,omitempty"`     // ArticleSortNewest when empty
+	Language    string     `json:"language,omitempty"` // published translations into it replace title, excerpt and slug
+
+	// Cursor switches to keyset pagination in Sort order: no total is
+	// counted and the page starts after the cursor
+	Cursor *pagination.Cursor `json:"-"`
 }
 
 type ArticleListResult struct {
@@ -75,35 +116,55 @@ type ArticleListResult struct {
 	Total      int        `json:"total"`
 	Page       int        `json:"page"`
 	TotalPages int        `json:"totalPages"`
+	NextCursor string     `json:"nextCursor,omitempty"` // keyset mode only; empty on the last page
 }
 
 type SearchParams struct {
-	Query string `json:"query"`
-	Page  int    `json:"page"`
-	Limit int    `json:"limit"`
+	Query    string     `json:"query"`
+	Page     int        `json:"page"`
+	Limit    int        `json:"limit"`
+	Category string     `json:"category,omitempty"` // category slug
+	Tag      string     `json:"tag,omitempty"`
+	From     *time.Time `json:"from,omitempty"`     // published on or after
+	To       *time.Time `json:"to,omitempty"`       // published before
+	Language string     `json:"language,omitempty"` // search configuration; empty searches every language
+	Prefer   string     `json:"-"`                  // language ranked first when searching every language
 }
 
 type CreateArticleRequest struct {
 	Title      string   `json:"title" binding:"required"`
-	Excerpt    string   `json:"excerpt" binding:"required"`
+	Excerpt    string   `json:"excerpt"`
 	Content    string   `json:"content" binding:"required"`
 	CategoryID string   `json:"categoryId" binding:"required"`
 	Featured   bool     `json:"featured"`
 	Published  bool     `json:"published"`
 	Tags       []string `json:"tags"`
+	Language   string   `json:"language,omitempty"` // detected from the content when empty
+	Slug       string   `json:"slug,omitempty"`     // generated from the title when empty
+	Thumbnail  string   `json:"thumbnail,omitempty"`
+
+	PublishAt   *time.Time `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time `json:"unpublishAt,omitempty"`
 }
 
 type UpdateArticleRequest struct {
-	Title      string   `json:"title,omitempty"`
-	Excerpt    string   `json:"excerpt,omitempty"`
-	Content    string   `json:"content,omitempty"`
-	Thumbnail  string   `json:"thumbnail,omitempty"`
-	CategoryID string   `json:"categoryId,omitempty"`
-	Slug       string   `json:"slug,omitempty"`
-	ReadTime   int      `json:"readTime,omitempty"`
-	Featured   *bool    `json:"featured,omitempty"`
-	Published  *bool    `json:"published,omitempty"`
-	Tags       []string `json:"tags,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Excerpt     string   `json:"excerpt,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	ContentText string   `json:"-"`
+	Thumbnail   string   `json:"thumbnail,omitempty"`
+	CategoryID  string   `json:"categoryId,omitempty"`
+	Slug        string   `json:"slug,omitempty"`
+	ReadTime    int      `json:"readTime,omitempty"`
+	Featured    *bool    `json:"featured,omitempty"`
+	Published   *bool    `json:"published,omitempty"`
+	Status      string   `json:"-"` // set by the workflow, never by clients
+	Tags        []string `json:"tags,omitempty"`
+	Language    string   `json:"language,omitempty"`
+
+	PublishAt     *time.Time `json:"publishAt,omitempty"`
+	UnpublishAt   *time.Time `json:"unpublishAt,omitempty"`
+	ClearSchedule bool       `json:"clearSchedule,omitempty"` // removes publishAt and unpublishAt
 }
 
 // Errors
@@ -114,8 +175,19 @@ var (
 	ErrEmailNotSubscribed      = errors.New("email not subscribed")
 	ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
 	ErrSlugAlreadyExists       = errors.New("slug already exists")
+	ErrInvalidSchedule         = errors.New("unpublish time must be after publish time")
 )
 
+// ArticleHistory is what a create or update records about itself, in the
+// same transaction as the change
+type ArticleHistory struct {
+	// RevisionID, when set, stores a revision snapshotting the article as saved
+	RevisionID string
+	AuthorID   string
+	// Transition, when set, records the workflow step the update makes
+	Transition *ArticleTransition
+}
+
 // Repository interfaces
 type ArticleRepository interface {
 	GetAll(ctx context.Context, params ArticleListParams) (*ArticleListResult, error)
@@ -123,12 +195,20 @@ type ArticleRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*Article, error)
 	GetFeatured(ctx context.Context) (*Article, error)
 	Search(ctx context.Context, params SearchParams) (*ArticleListResult, error)
-	Create(ctx context.Context, article *Article) error
-	Update(ctx context.Context, id string, updates UpdateArticleRequest) error
+	Create(ctx context.Context, article *Article, history ArticleHistory) error
+	Update(ctx context.Context, id string, updates UpdateArticleRequest, history ArticleHistory) error
 	Delete(ctx context.Context, id string) error
-	IncrementViewCount(ctx context.Context, id string) error
-	IncrementLikeCount(ctx context.Context, id string) (int, error)
+	// RecordView stores a view unless the visitor already viewed the article
+	// within window; it reports whether the view was counted
+	RecordView(ctx context.Context, id string, visitor Visitor, window time.Duration) (bool, error)
+	// ToggleLike likes the article for the visitor, or removes an existing like
+	ToggleLike(ctx context.Context, id string, visitor Visitor) (*LikeState, error)
+	GetLikeState(ctx context.Context, id string, visitor Visitor) (*LikeState, error)
 	GetStats(ctx context.Context, id string) (*ArticleStats, error)
+	ApplyPublishSchedule(ctx context.Context, now time.Time) (published int, unpublished int, err error)
+	// Bulk applies req to every listed article in one transaction. An
+	// article is only published when publishable accepts its content.
+	Bulk(ctx context.Context, req bulk.Request, actor Actor, publishable func(content string) error) (*bulk.Report, error)
 }
 
 type CategoryRepository interface {
@@ -151,16 +231,48 @@ type NewsletterRepository interface {
 type ArticleUsecase interface {
 	GetArticles(ctx context.Context, params ArticleListParams) (*ArticleListResult, error)
 	GetArticleByID(ctx context.Context, id string) (*Article, error)
-	GetArticleBySlug(ctx context.Context, slug string) (*Article, error)
+	// GetArticleBySlug also finds articles by a translation's slug, and shows
+	// the published translation into the chosen language, falling back to the
+	// source. It returns a *SlugRedirect error for an old slug.
+	GetArticleBySlug(ctx context.Context, slug string, lang LanguageChoice) (*Article, error)
 	GetFeaturedArticle(ctx context.Context) (*Article, error)
 	SearchArticles(ctx context.Context, params SearchParams) (*ArticleListResult, error)
-	CreateArticle(ctx context.Context, req CreateArticleRequest, authorID string) (*Article, error)
-	UpdateArticle(ctx context.Context, id string, req UpdateArticleRequest) (*Article, error)
-	DeleteArticle(ctx context.Context, id string) error
-	TrackArticleView(ctx context.Context, id string) error
-	TrackArticleLike(ctx context.Context, id string) (int, error)
+	// CreateArticle and UpdateArticle lint the content. A draft is saved
+	// with the report; content going public is refused with a
+	// *ContentLintError when a blocking rule fails. TransitionArticle,
+	// BulkArticles and SaveArticleTranslation check it the same way.
+	CreateArticle(ctx context.Context, req CreateArticleRequest, author Actor) (*Article, error)
+	// UpdateArticle treats changes to Published and PublishAt as workflow
+	// transitions, so they are subject to the editor's role. Writers may
+	// only change their own articles (ErrNotArticleAuthor), and approved and
+	// published articles are ErrArticleLocked for them.
+	UpdateArticle(ctx context.Context, id string, req UpdateArticleRequest, editor Actor) (*Article, error)
+	// LintContent checks content without saving anything
+	LintContent(content string) *LintReport
+	// DeleteArticle is for editors only
+	DeleteArticle(ctx context.Context, id string, actor Actor) error
+	TrackArticleView(ctx context.Context, id string, visitor Visitor) error
+	ToggleArticleLike(ctx context.Context, id string, visitor Visitor) (*LikeState, error)
+	GetArticleLike(ctx context.Context, id string, visitor Visitor) (*LikeState, error)
 	GetArticleStats(ctx context.Context, id string) (*ArticleStats, error)
 	GetDefaultAuthorID(ctx context.Context) (string, error)
+	GetArticleRevisions(ctx context.Context, articleID string) ([]*ArticleRevision, error)
+	GetArticleRevision(ctx context.Context, articleID, revisionID string) (*ArticleRevision, error)
+	DiffArticleRevisions(ctx context.Context, articleID, fromID, toID string) (*RevisionDiff, error)
+	RestoreArticleRevision(ctx context.Context, articleID, revisionID string, editor Actor) (*Article, error)
+	GetRelatedArticles(ctx context.Context, slug string, limit int) ([]RelatedArticle, error)
+	GetRelatedPins(ctx context.Context, articleID string) ([]string, error)
+	SetRelatedPins(ctx context.Context, articleID string, relatedIDs []string) ([]RelatedArticle, error)
+	TransitionArticle(ctx context.Context, id string, req ArticleTransitionRequest, actor Actor) (*Article, error)
+	GetArticleTransitions(ctx context.Context, id string) ([]*ArticleTransition, error)
+	GetArticleTranslations(ctx context.Context, articleID string) ([]*ArticleTranslation, error)
+	// SaveArticleTranslation and DeleteArticleTranslation follow the
+	// article's rules: writers may only touch their own articles, and
+	// publishing a translation, changing a published one or any translation
+	// of an approved or published article is for editors.
+	SaveArticleTranslation(ctx context.Context, articleID, lang string, req SaveArticleTranslationRequest, actor Actor) (*ArticleTranslation, error)
+	DeleteArticleTranslation(ctx context.Context, articleID, lang string, actor Actor) error
+	BulkArticles(ctx context.Context, req bulk.Request, actor Actor) (*bulk.Report, error)
 }
 
 type NewsletterUsecase interface {
@@ -179,32 +291,105 @@ type CategoryUsecase interface {
 }
 
 // Utility functions
+func (a *Article) RenderContentText() {
+	a.ContentText = ParseEditorJS(a.Content).PlainText()
+}
+
 func (a *Article) CalculateReadTime() {
+	if a.ContentText == "" {
+		a.RenderContentText()
+	}
+
 	// Rough estimate: 200 words per minute
-	wordCount := len(strings.Fields(a.Content))
+	wordCount := len(strings.Fields(a.ContentText))
 	a.ReadTime = max(1, wordCount/200)
 }
 
+// ValidateSchedule checks a publish/unpublish pair
+func ValidateSchedule(publishAt, unpublishAt *time.Time) error {
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// GenerateExcerpt fills an empty excerpt from the first words of the plain-text content
+func (a *Article) GenerateExcerpt() {
+	if strings.TrimSpace(a.Excerpt) != "" {
+		return
+	}
+	if a.ContentText == "" {
+		a.RenderContentText()
+	}
+
+	words := strings.Fields(a.ContentText)
+	if len(words) > excerptWordCount {
+		a.Excerpt = strings.Join(words[:excerptWordCount], " ") + "..."
+		return
+	}
+	a.Excerpt = strings.Join(words, " ")
+}
+
+const excerptWordCount = 40
+
 func (a *Article) GenerateSlug() {
+	a.Slug = Slugify(a.Title)
+}
+
+// Symbols that tell tag names apart ("C", "C#", "C++") are spelled out
+// when they follow a letter or digit
+var slugSymbols = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`([\p{L}\p{N}])\+\+`), "${1}pp"},
+	{regexp.MustCompile(`([\p{L}\p{N}])#`), "${1}-sharp-"},
+	{regexp.MustCompile(`([\p{L}\p{N}])\+`), "${1}-plus-"},
+}
+
+// Slugify lower-cases s, spells out "#" and "+" after a word ("c#" is
+// "c-sharp", "c++" is "cpp"), turns spaces into hyphens and drops everything
+// except letters, digits, hyphens and underscores. Letters outside ASCII
+// are kept, so the result is only empty when s has no letters or digits.
+func Slugify(s string) string {
 	// Convert to lowercase
-	slug := strings.ToLower(strings.TrimSpace(a.Title))
+	slug := strings.ToLower(strings.TrimSpace(s))
+
+	for _, symbol := range slugSymbols {
+		slug = symbol.pattern.ReplaceAllString(slug, symbol.replacement)
+	}
 
 	// Replace spaces with hyphens
 	slug = strings.ReplaceAll(slug, " ", "-")
 
-	// Remove or replace special characters
-	// Keep only alphanumeric characters, hyphens, and underscores
-	reg := regexp.MustCompile("[^a-z0-9-_]+")
-	slug = reg.ReplaceAllString(slug, "")
+	// Keep only letters, digits (with their combining marks), hyphens and underscores
+	slug = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || (r >= utf8.RuneSelf && unicode.Is(unicode.Mn, r)) {
+			return r
+		}
+		return -1
+	}, slug)
 
 	// Remove multiple consecutive hyphens
-	reg = regexp.MustCompile("-+")
+	reg := regexp.MustCompile("-+")
 	slug = reg.ReplaceAllString(slug, "-")
 
 	// Trim hyphens from start and end
-	slug = strings.Trim(slug, "-")
+	return strings.Trim(slug, "-")
+}
 
-	a.Slug = slug
+// slugSuffix is the numeric suffix ("-2", "-3", ...) added to a slug that
+// is already taken
+var slugSuffix = regexp.MustCompile(`-[0-9]+$`)
+
+// IsGeneratedSlug reports whether slug is the one generated from title,
+// with or without the suffix added when the plain slug was taken
+func IsGeneratedSlug(slug, title string) bool {
+	base := Slugify(title)
+	if base == "" {
+		return false
+	}
+	return slug == base || slugSuffix.ReplaceAllString(slug, "") == base
 }
 
 func max(a, b int) int {
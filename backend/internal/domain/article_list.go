@@ -0,0 +1,77 @@
+package domain
+
+import "errors"
+
+// Orders of the public article list
+const (
+	ArticleSortNewest     = "newest"
+	ArticleSortOldest     = "oldest"
+	ArticleSortMostViewed = "most_viewed"
+	ArticleSortMostLiked  = "most_liked"
+	ArticleSortTrending   = "trending" // views plus weighted likes over the last TrendingWindowDays
+)
+
+// How a list filtered by several tags matches them
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+const (
+	TrendingWindowDays = 7
+	TrendingLikeWeight = 3 // a like counts as this many views
+)
+
+var (
+	ErrInvalidArticleSort  = errors.New("sort must be newest, oldest, most_viewed, most_liked or trending")
+	ErrInvalidTagMatch     = errors.New("tagMatch must be any or all")
+	ErrTagMatchWithoutTags = errors.New("tagMatch needs at least one tag")
+	ErrInvalidDateRange    = errors.New("from must be before to")
+	ErrInvalidMinReadTime  = errors.New("minReadTime must not be negative")
+	ErrCursorWithRanking   = errors.New("most_viewed, most_liked and trending change between requests and cannot be paginated with a cursor; use page")
+	ErrCursorSortMismatch  = errors.New("cursor was issued for a different sort")
+)
+
+// Validate checks that the filters and sort make sense together, filling in
+// the default sort and tag match
+func (p *ArticleListParams) Validate() error {
+	switch p.Sort {
+	case "":
+		p.Sort = ArticleSortNewest
+	case ArticleSortNewest, ArticleSortOldest, ArticleSortMostViewed, ArticleSortMostLiked, ArticleSortTrending:
+	default:
+		return ErrInvalidArticleSort
+	}
+
+	switch p.TagMatch {
+	case "":
+		p.TagMatch = TagMatchAny
+	case TagMatchAny, TagMatchAll:
+		if len(p.Tags) == 0 {
+			return ErrTagMatchWithoutTags
+		}
+	default:
+		return ErrInvalidTagMatch
+	}
+
+	if p.From != nil && p.To != nil && !p.From.Before(*p.To) {
+		return ErrInvalidDateRange
+	}
+	if p.MinReadTime < 0 {
+		return ErrInvalidMinReadTime
+	}
+
+	if p.Cursor != nil {
+		// Counts and scores move while a reader pages, so a keyset on them
+		// would skip or repeat articles
+		switch p.Sort {
+		case ArticleSortMostViewed, ArticleSortMostLiked, ArticleSortTrending:
+			return ErrCursorWithRanking
+		}
+		if !p.Cursor.IsStart() && p.Cursor.Sort != p.Sort {
+			return ErrCursorSortMismatch
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Outcomes of importing one Markdown file
+const (
+	ImportStatusCreated = "created"
+	ImportStatusSkipped = "skipped"
+	ImportStatusFailed  = "failed"
+)
+
+// Import limits, guarding against oversized uploads and zip bombs
+const (
+	MaxMarkdownFileSize   = 5 << 20
+	MaxMarkdownImportSize = 50 << 20
+)
+
+// MarkdownFile is one article in Markdown with YAML front matter
+type MarkdownFile struct {
+	Name    string `json:"name"`
+	Content []byte `json:"-"`
+}
+
+// ArticleFrontMatter is the YAML header of an imported or exported article.
+// Category is the category slug; Date is the publish date of published
+// articles, as YYYY-MM-DD or RFC 3339.
+type ArticleFrontMatter struct {
+	Title     string   `yaml:"title"`
+	Slug      string   `yaml:"slug,omitempty"`
+	Category  string   `yaml:"category"`
+	Tags      []string `yaml:"tags,omitempty"`
+	Published bool     `yaml:"published"`
+	Date      string   `yaml:"date,omitempty"`
+	Excerpt   string   `yaml:"excerpt,omitempty"`
+	Thumbnail string   `yaml:"thumbnail,omitempty"`
+	Featured  bool     `yaml:"featured,omitempty"`
+	Language  string   `yaml:"language,omitempty"`
+}
+
+// ArticleImportResult reports what happened to one imported file
+type ArticleImportResult struct {
+	File      string `json:"file"`
+	Status    string `json:"status"`
+	ArticleID string `json:"articleId,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+var (
+	ErrMissingFrontMatter = errors.New("file must start with a YAML front matter block")
+	ErrInvalidFrontMatter = errors.New("invalid front matter")
+	ErrInvalidArticleDate = errors.New("date must be YYYY-MM-DD or RFC 3339")
+	ErrInvalidArchive     = errors.New("archive is not a valid zip file")
+	ErrMarkdownTooLarge   = errors.New("markdown file is too large")
+)
+
+type ArticleMarkdownUsecase interface {
+	// ImportMarkdown creates one article per file. Files whose slug is
+	// already taken are skipped, and a failing file does not stop the rest.
+	// An author without an ID falls back to the default author. Files marked
+	// published fail unless the author is an editor.
+	ImportMarkdown(ctx context.Context, files []MarkdownFile, author Actor) ([]ArticleImportResult, error)
+	// ExportMarkdown renders every article, drafts included, as a Markdown
+	// file named after its slug
+	ExportMarkdown(ctx context.Context) ([]MarkdownFile, error)
+}
+
+// ParseArticleMarkdown splits a Markdown file into its front matter and body
+func ParseArticleMarkdown(content []byte) (*ArticleFrontMatter, string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")) // UTF-8 byte order mark
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+
+	if !bytes.HasPrefix(content, []byte("---\n")) {
+		return nil, "", ErrMissingFrontMatter
+	}
+	rest := content[len("---\n"):]
+
+	end := bytes.Index(rest, []byte("\n---\n"))
+	var header, body []byte
+	switch {
+	case end >= 0:
+		header, body = rest[:end], rest[end+len("\n---\n"):]
+	case bytes.HasSuffix(rest, []byte("\n---")):
+		header = rest[:len(rest)-len("\n---")]
+	case bytes.HasPrefix(rest, []byte("---\n")):
+		body = rest[len("---\n"):] // empty front matter
+	default:
+		return nil, "", ErrMissingFrontMatter
+	}
+
+	var meta ArticleFrontMatter
+	if err := yaml.Unmarshal(header, &meta); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidFrontMatter, err)
+	}
+	meta.Title = strings.TrimSpace(meta.Title)
+	meta.Category = strings.TrimSpace(meta.Category)
+	if meta.Title == "" {
+		return nil, "", fmt.Errorf("%w: title is required", ErrInvalidFrontMatter)
+	}
+	if meta.Category == "" {
+		return nil, "", fmt.Errorf("%w: category is required", ErrInvalidFrontMatter)
+	}
+
+	return &meta, string(body), nil
+}
+
+// PublishDate parses the front matter date; a missing date is nil
+func (f *ArticleFrontMatter) PublishDate() (*time.Time, error) {
+	date := strings.TrimSpace(f.Date)
+	if date == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, ErrInvalidArticleDate
+}
+
+// FormatArticleMarkdown renders an article as Markdown with front matter
+func FormatArticleMarkdown(article *Article) ([]byte, error) {
+	meta := ArticleFrontMatter{
+		Title:     article.Title,
+		Slug:      article.Slug,
+		Category:  article.Category.Slug,
+		Tags:      article.Tags,
+		Published: article.Published,
+		Excerpt:   article.Excerpt,
+		Thumbnail: article.Thumbnail,
+		Featured:  article.Featured,
+		Language:  article.Language,
+	}
+	if article.Published {
+		meta.Date = article.PublishedAt.UTC().Format(time.RFC3339)
+	}
+
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	b.Write(header)
+	b.WriteString("---\n\n")
+	b.WriteString(ParseEditorJS(article.Content).Markdown())
+	return b.Bytes(), nil
+}
+
+// IsMarkdownFile reports whether a file name looks like a Markdown article
+func IsMarkdownFile(name string) bool {
+	base := path.Base(name)
+	if strings.HasPrefix(base, ".") || strings.HasPrefix(name, "__MACOSX/") {
+		return false
+	}
+	ext := strings.ToLower(path.Ext(base))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// ReadMarkdownArchive returns the Markdown files of a zip archive, in name
+// order. Other files, such as images kept next to the articles, are ignored.
+func ReadMarkdownArchive(data []byte) ([]MarkdownFile, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+
+	var files []MarkdownFile
+	total := 0
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || !IsMarkdownFile(entry.Name) {
+			continue
+		}
+
+		r, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", entry.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(r, MaxMarkdownFileSize+1))
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name, err)
+		}
+		if len(content) > MaxMarkdownFileSize {
+			return nil, fmt.Errorf("%w: %s", ErrMarkdownTooLarge, entry.Name)
+		}
+		if total += len(content); total > MaxMarkdownImportSize {
+			return nil, ErrMarkdownTooLarge
+		}
+
+		files = append(files, MarkdownFile{Name: entry.Name, Content: content})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// WriteMarkdownArchive writes files into a zip archive
+func WriteMarkdownArchive(w io.Writer, files []MarkdownFile) error {
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		entry, err := archive.Create(file.Name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", file.Name, err)
+		}
+		if _, err := entry.Write(file.Content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+	return archive.Close()
+}
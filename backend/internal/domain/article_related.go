@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// RelatedArticle is a suggested follow-up read for an article
+type RelatedArticle struct {
+	Article *Article `json:"article"`
+	Score   float64  `json:"score"`
+	Pinned  bool     `json:"pinned"` // chosen by an admin rather than scored
+}
+
+// Weights of the related-article score components; each component is in [0, 1]
+const (
+	relatedTagWeight      = 0.5
+	relatedCategoryWeight = 0.2
+	relatedTextWeight     = 0.3
+)
+
+var ErrInvalidRelatedArticle = errors.New("related article must be another existing article")
+
+type ArticleRelationRepository interface {
+	// GetCandidates returns every publicly visible article with tags and content text loaded
+	GetCandidates(ctx context.Context) ([]*Article, error)
+	// GetPinned returns the visible pinned articles for an article, in pin order
+	GetPinned(ctx context.Context, articleID string) ([]*Article, error)
+	GetPinnedIDs(ctx context.Context, articleID string) ([]string, error)
+	SetPinned(ctx context.Context, articleID string, relatedIDs []string) error
+}
+
+// ScoreRelated ranks candidates by similarity to target: shared tags (Jaccard),
+// same category, and TF-IDF cosine similarity of title and body text. The
+// target itself and candidates with a zero score are left out.
+func ScoreRelated(target *Article, candidates []*Article) []RelatedArticle {
+	others := make([]*Article, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.ID != target.ID {
+			others = append(others, candidate)
+		}
+	}
+
+	// IDF is computed over the target and every candidate
+	documents := make([]map[string]float64, len(others)+1)
+	documents[0] = termFrequencies(target.Title + " " + target.ContentText)
+	for i, candidate := range others {
+		documents[i+1] = termFrequencies(candidate.Title + " " + candidate.ContentText)
+	}
+	idf := inverseDocumentFrequencies(documents)
+	targetVector := tfidf(documents[0], idf)
+
+	results := make([]RelatedArticle, 0, len(others))
+	for i, candidate := range others {
+		score := relatedTagWeight * tagSimilarity(target.Tags, candidate.Tags)
+		if candidate.Category.ID == target.Category.ID {
+			score += relatedCategoryWeight
+		}
+		score += relatedTextWeight * cosine(targetVector, tfidf(documents[i+1], idf))
+
+		if score > 0 {
+			results = append(results, RelatedArticle{Article: candidate, Score: math.Round(score*1000) / 1000})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Article.PublishedAt.After(results[j].Article.PublishedAt)
+	})
+	return results
+}
+
+func tagSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	set := make(map[string]bool, len(a))
+	for _, tag := range a {
+		set[strings.ToLower(tag)] = true
+	}
+
+	shared := 0
+	union := len(set)
+	seen := make(map[string]bool, len(b))
+	for _, tag := range b {
+		tag = strings.ToLower(tag)
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		if set[tag] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	return float64(shared) / float64(union)
+}
+
+// termFrequencies counts lower-cased words, skipping stop words and very short tokens
+func termFrequencies(text string) map[string]float64 {
+	terms := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, word := range words {
+		if len(word) < 3 || englishStopWords[word] || indonesianStopWords[word] {
+			continue
+		}
+		terms[word]++
+	}
+	return terms
+}
+
+func inverseDocumentFrequencies(documents []map[string]float64) map[string]float64 {
+	counts := make(map[string]int)
+	for _, document := range documents {
+		for term := range document {
+			counts[term]++
+		}
+	}
+
+	idf := make(map[string]float64, len(counts))
+	n := float64(len(documents))
+	for term, count := range counts {
+		idf[term] = math.Log(1 + n/float64(count))
+	}
+	return idf
+}
+
+func tfidf(terms, idf map[string]float64) map[string]float64 {
+	vector := make(map[string]float64, len(terms))
+	for term, count := range terms {
+		vector[term] = (1 + math.Log(count)) * idf[term]
+	}
+	return vector
+}
+
+func cosine(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, weight := range a {
+		normA += weight * weight
+		if other, ok := b[term]; ok {
+			dot += weight * other
+		}
+	}
+	for _, weight := range b {
+		normB += weight * weight
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ArticleRevision is a snapshot of an article taken on every create and update
+type ArticleRevision struct {
+	ID             string    `json:"id"`
+	ArticleID      string    `json:"articleId"`
+	RevisionNumber int       `json:"revisionNumber"`
+	Title          string    `json:"title"`
+	Excerpt        string    `json:"excerpt"`
+	Content        string    `json:"content,omitempty"`
+	CategoryID     string    `json:"categoryId"`
+	Tags           []string  `json:"tags"`
+	AuthorID       string    `json:"authorId"`
+	AuthorName     string    `json:"authorName"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// Block diff operations
+const (
+	BlockDiffEqual    = "equal"
+	BlockDiffAdded    = "added"
+	BlockDiffRemoved  = "removed"
+	BlockDiffModified = "modified"
+)
+
+// FieldChange describes a changed scalar field between two revisions
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// BlockDiff describes one EditorJS block in a revision diff
+type BlockDiff struct {
+	Op   string         `json:"op"`
+	From *EditorJSBlock `json:"from,omitempty"`
+	To   *EditorJSBlock `json:"to,omitempty"`
+}
+
+// RevisionDiff is the block-by-block difference between two revisions
+type RevisionDiff struct {
+	From   *ArticleRevision `json:"from"`
+	To     *ArticleRevision `json:"to"`
+	Fields []FieldChange    `json:"fields"`
+	Blocks []BlockDiff      `json:"blocks"`
+}
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+type ArticleRevisionRepository interface {
+	Create(ctx context.Context, revision *ArticleRevision) error
+	GetByArticle(ctx context.Context, articleID string) ([]*ArticleRevision, error)
+	GetByID(ctx context.Context, articleID, id string) (*ArticleRevision, error)
+}
+
+// NewArticleRevision snapshots the current state of an article
+func NewArticleRevision(id string, article *Article, authorID string) *ArticleRevision {
+	tags := article.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &ArticleRevision{
+		ID:         id,
+		ArticleID:  article.ID,
+		Title:      article.Title,
+		Excerpt:    article.Excerpt,
+		Content:    article.Content,
+		CategoryID: article.Category.ID,
+		Tags:       tags,
+		AuthorID:   authorID,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// DiffRevisions compares two revisions field by field and block by block
+func DiffRevisions(from, to *ArticleRevision) *RevisionDiff {
+	diff := &RevisionDiff{From: from, To: to, Fields: []FieldChange{}}
+
+	if from.Title != to.Title {
+		diff.Fields = append(diff.Fields, FieldChange{Field: "title", From: from.Title, To: to.Title})
+	}
+	if from.Excerpt != to.Excerpt {
+		diff.Fields = append(diff.Fields, FieldChange{Field: "excerpt", From: from.Excerpt, To: to.Excerpt})
+	}
+	if from.CategoryID != to.CategoryID {
+		diff.Fields = append(diff.Fields, FieldChange{Field: "categoryId", From: from.CategoryID, To: to.CategoryID})
+	}
+	if !equalStrings(from.Tags, to.Tags) {
+		diff.Fields = append(diff.Fields, FieldChange{Field: "tags", From: from.Tags, To: to.Tags})
+	}
+
+	diff.Blocks = DiffBlocks(ParseEditorJS(from.Content).Blocks, ParseEditorJS(to.Content).Blocks)
+	return diff
+}
+
+// DiffBlocks aligns two block lists with a longest-common-subsequence pass.
+// A removal directly followed by an addition of a block with the same
+// EditorJS ID (or the same type when IDs are missing) is reported as modified.
+func DiffBlocks(a, b []EditorJSBlock) []BlockDiff {
+	keysA := blockKeys(a)
+	keysB := blockKeys(b)
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if keysA[i] == keysB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var raw []BlockDiff
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case keysA[i] == keysB[j]:
+			raw = append(raw, BlockDiff{Op: BlockDiffEqual, From: &a[i], To: &b[j]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			raw = append(raw, BlockDiff{Op: BlockDiffRemoved, From: &a[i]})
+			i++
+		default:
+			raw = append(raw, BlockDiff{Op: BlockDiffAdded, To: &b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		raw = append(raw, BlockDiff{Op: BlockDiffRemoved, From: &a[i]})
+	}
+	for ; j < len(b); j++ {
+		raw = append(raw, BlockDiff{Op: BlockDiffAdded, To: &b[j]})
+	}
+
+	result := make([]BlockDiff, 0, len(raw))
+	for k := 0; k < len(raw); k++ {
+		if k+1 < len(raw) && raw[k].Op == BlockDiffRemoved && raw[k+1].Op == BlockDiffAdded &&
+			sameBlock(raw[k].From, raw[k+1].To) {
+			result = append(result, BlockDiff{Op: BlockDiffModified, From: raw[k].From, To: raw[k+1].To})
+			k++
+			continue
+		}
+		result = append(result, raw[k])
+	}
+	return result
+}
+
+func blockKeys(blocks []EditorJSBlock) []string {
+	keys := make([]string, len(blocks))
+	for i, block := range blocks {
+		// Normalize the data so key order and whitespace don't count as changes
+		var data interface{}
+		normalized := block.Data
+		if json.Unmarshal(block.Data, &data) == nil {
+			normalized, _ = json.Marshal(data)
+		}
+		keys[i] = block.Type + ":" + string(normalized)
+	}
+	return keys
+}
+
+func sameBlock(a, b *EditorJSBlock) bool {
+	if a.ID != "" && b.ID != "" {
+		return a.ID == b.ID
+	}
+	return a.Type == b.Type
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
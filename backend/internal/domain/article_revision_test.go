@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func paragraph(id, text string) EditorJSBlock {
+	data, _ := json.Marshal(map[string]string{"text": text})
+	return EditorJSBlock{ID: id, Type: "paragraph", Data: data}
+}
+
+// describeDiff reduces a diff to "op from->to" lines using block text
+func describeDiff(diff []BlockDiff) []string {
+	text := func(b *EditorJSBlock) string {
+		if b == nil {
+			return ""
+		}
+		return b.data().Text
+	}
+	lines := make([]string, 0, len(diff))
+	for _, d := range diff {
+		lines = append(lines, d.Op+" "+text(d.From)+"->"+text(d.To))
+	}
+	return lines
+}
+
+func TestDiffBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []EditorJSBlock
+		want []string
+	}{
+		{
+			name: "empty",
+			want: []string{},
+		},
+		{
+			name: "unchanged",
+			a:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b")},
+			b:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b")},
+			want: []string{"equal a->a", "equal b->b"},
+		},
+		{
+			name: "key order and whitespace in data are not changes",
+			a:    []EditorJSBlock{{ID: "1", Type: "header", Data: json.RawMessage(`{"text":"a","level":2}`)}},
+			b:    []EditorJSBlock{{ID: "1", Type: "header", Data: json.RawMessage(`{ "level": 2, "text": "a" }`)}},
+			want: []string{"equal a->a"},
+		},
+		{
+			name: "added and removed",
+			a:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b")},
+			b:    []EditorJSBlock{paragraph("2", "b"), paragraph("3", "c")},
+			want: []string{"removed a->", "equal b->b", "added ->c"},
+		},
+		{
+			name: "edited block with the same id",
+			a:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b")},
+			b:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b, edited")},
+			want: []string{"equal a->a", "modified b->b, edited"},
+		},
+		{
+			name: "replaced block with another id",
+			a:    []EditorJSBlock{paragraph("1", "a")},
+			b:    []EditorJSBlock{paragraph("2", "z")},
+			want: []string{"removed a->", "added ->z"},
+		},
+		{
+			name: "edited block without ids matches on type",
+			a:    []EditorJSBlock{paragraph("", "a")},
+			b:    []EditorJSBlock{paragraph("", "a2")},
+			want: []string{"modified a->a2"},
+		},
+		{
+			name: "moved block",
+			a:    []EditorJSBlock{paragraph("1", "a"), paragraph("2", "b"), paragraph("3", "c")},
+			b:    []EditorJSBlock{paragraph("3", "c"), paragraph("1", "a"), paragraph("2", "b")},
+			want: []string{"added ->c", "equal a->a", "equal b->b", "removed c->"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeDiff(DiffBlocks(tt.a, tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffBlocks() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "testing"
+
+func TestIsGeneratedSlug(t *testing.T) {
+	tests := []struct {
+		slug, title string
+		want        bool
+	}{
+		{"hello-world", "Hello World", true},
+		{"hello-world-2", "Hello World", true},
+		{"hello-world-12", "Hello World", true},
+		{"top-10", "Top 10", true},
+		{"top-10-2", "Top 10", true},
+		{"top", "Top 10", false},
+		{"my-own-slug", "Hello World", false},
+		{"hello-world-draft", "Hello World", false},
+		{"hello", "Hello World", false},
+		{"", "!!!", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsGeneratedSlug(tt.slug, tt.title); got != tt.want {
+			t.Errorf("IsGeneratedSlug(%q, %q) = %v, want %v", tt.slug, tt.title, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// History actions recorded in an article's transitions when a translation
+// changes. They leave the article's status as is and are not workflow
+// actions a client can request.
+const (
+	ArticleActionSaveTranslation    = "save_translation"
+	ArticleActionPublishTranslation = "publish_translation"
+	ArticleActionDeleteTranslation  = "delete_translation"
+)
+
+var (
+	ErrTranslationNotFound     = errors.New("translation not found")
+	ErrTranslationSameLanguage = errors.New("translation language must differ from the article's language")
+)
+
+// ArticleTranslation is an article's title, excerpt and content in another
+// language. It is public only when published and the article itself is.
+type ArticleTranslation struct {
+	ID        string    `json:"id" db:"id"`
+	ArticleID string    `json:"articleId" db:"article_id"`
+	Language  string    `json:"language" db:"language"`
+	Title     string    `json:"title" db:"title"`
+	Excerpt   string    `json:"excerpt" db:"excerpt"`
+	Content   string    `json:"content" db:"content"`
+	ReadTime  int       `json:"readTime" db:"read_time"`
+	Slug      string    `json:"slug" db:"slug"`
+	Published bool      `json:"published" db:"published"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+
+	ArticleSlug string `json:"-" db:"article_slug"` // slug of the source article
+
+	Lint *LintReport `json:"lint,omitempty" db:"-"` // set when saved
+}
+
+// TranslationLink points at one language version of an article, for
+// hreflang tags and language switchers
+type TranslationLink struct {
+	Language string `json:"language"`
+	Slug     string `json:"slug"`
+	Source   bool   `json:"source,omitempty"` // the original, used as x-default
+}
+
+type SaveArticleTranslationRequest struct {
+	Title     string `json:"title" binding:"required"`
+	Excerpt   string `json:"excerpt"`
+	Content   string `json:"content" binding:"required"`
+	Slug      string `json:"slug,omitempty"` // generated from the title when empty
+	Published bool   `json:"published"`
+}
+
+// LanguageChoice is the language a reader asked for. Explicit comes from
+// ?lang= and always wins; Preferred comes from Accept-Language and only
+// applies when the requested slug is not itself a translation's.
+type LanguageChoice struct {
+	Explicit  string
+	Preferred string
+}
+
+// Language is the chosen language, or "" when the reader has no preference
+func (l LanguageChoice) Language() string {
+	if l.Explicit != "" {
+		return l.Explicit
+	}
+	return l.Preferred
+}
+
+// ApplyTranslation shows the article in the translation's language, keeping
+// the source language in SourceLanguage
+func (a *Article) ApplyTranslation(t *ArticleTranslation) {
+	a.SourceLanguage = a.Language
+	a.Language = t.Language
+	a.Title = t.Title
+	a.Excerpt = t.Excerpt
+	a.Content = t.Content
+	a.ReadTime = t.ReadTime
+	a.Slug = t.Slug
+}
+
+type ArticleTranslationRepository interface {
+	GetByArticle(ctx context.Context, articleID string) ([]*ArticleTranslation, error)
+	Get(ctx context.Context, articleID, lang string) (*ArticleTranslation, error)
+	// GetBySlug finds a published translation by its own slug
+	GetBySlug(ctx context.Context, slug string) (*ArticleTranslation, error)
+	// GetPublished returns the published translations into lang of the
+	// given articles, keyed by article ID
+	GetPublished(ctx context.Context, articleIDs []string, lang string) (map[string]*ArticleTranslation, error)
+	// Save creates or replaces the translation for its article and language,
+	// suffixing the slug when it is taken. The transition is recorded in the
+	// same transaction.
+	Save(ctx context.Context, translation *ArticleTranslation, transition *ArticleTransition) error
+	Delete(ctx context.Context, articleID, lang string, transition *ArticleTransition) error
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Editorial states of an article. Only published articles are public, and
+// approved ones once their publish time has passed.
+const (
+	ArticleStatusDraft            = "draft"
+	ArticleStatusInReview         = "in_review"
+	ArticleStatusChangesRequested = "changes_requested"
+	ArticleStatusApproved         = "approved"
+	ArticleStatusPublished        = "published"
+	ArticleStatusArchived         = "archived"
+)
+
+// Workflow actions that move an article between states
+const (
+	ArticleActionSubmit         = "submit"
+	ArticleActionWithdraw       = "withdraw"
+	ArticleActionRequestChanges = "request_changes"
+	ArticleActionApprove        = "approve"
+	ArticleActionSchedule       = "schedule"
+	ArticleActionPublish        = "publish"
+	ArticleActionArchive        = "archive"
+	ArticleActionRestore        = "restore"
+)
+
+// Roles carried in the JWT. Admins and editors review and publish; writers
+// write and submit for review. Any other role may not touch articles.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleWriter = "writer"
+)
+
+var (
+	ErrInvalidArticleStatus      = errors.New("status must be draft, in_review, changes_requested, approved, published or archived")
+	ErrInvalidArticleAction      = errors.New("action must be submit, withdraw, request_changes, approve, schedule, publish, archive or restore")
+	ErrInvalidTransition         = errors.New("action is not allowed from the article's current status")
+	ErrTransitionForbidden       = errors.New("your role cannot perform this action")
+	ErrArticleLocked             = errors.New("approved and published articles can only be edited by an editor")
+	ErrNotArticleAuthor          = errors.New("writers can only change their own articles")
+	ErrTransitionCommentRequired = errors.New("a comment is required when requesting changes")
+	ErrSchedulePublishAtRequired = errors.New("schedule needs a future publishAt")
+)
+
+// Actor is the signed-in user behind a change
+type Actor struct {
+	ID   string
+	Role string
+}
+
+// IsEditor reports whether the actor may approve and publish
+func (a Actor) IsEditor() bool {
+	return a.Role == RoleAdmin || a.Role == RoleEditor
+}
+
+// IsWriter reports whether the actor may write articles at all
+func (a Actor) IsWriter() bool {
+	return a.IsEditor() || a.Role == RoleWriter
+}
+
+// CanChange reports whether the actor may edit the article and move it
+// through the workflow: editors may change any article, writers only
+// their own
+func (a Actor) CanChange(article *Article) bool {
+	return a.IsEditor() || (a.IsWriter() && article.Author.ID == a.ID)
+}
+
+// IsPublic reports whether the public may see the article, honouring its
+// publish schedule the same way the repository does: approved articles go
+// live at their publish time, and every article goes down at its unpublish time
+func (a *Article) IsPublic(now time.Time) bool {
+	live := a.Published || (a.Status == ArticleStatusApproved && a.PublishAt != nil && !a.PublishAt.After(now))
+	return live && (a.UnpublishAt == nil || a.UnpublishAt.After(now))
+}
+
+type articleTransitionRule struct {
+	from       []string
+	to         string
+	editorOnly bool
+}
+
+var articleTransitions = map[string]articleTransitionRule{
+	ArticleActionSubmit: {
+		from: []string{ArticleStatusDraft, ArticleStatusChangesRequested},
+		to:   ArticleStatusInReview,
+	},
+	ArticleActionWithdraw: {
+		from: []string{ArticleStatusInReview},
+		to:   ArticleStatusDraft,
+	},
+	ArticleActionRequestChanges: {
+		from:       []string{ArticleStatusInReview, ArticleStatusApproved},
+		to:         ArticleStatusChangesRequested,
+		editorOnly: true,
+	},
+	ArticleActionApprove: {
+		from:       []string{ArticleStatusInReview},
+		to:         ArticleStatusApproved,
+		editorOnly: true,
+	},
+	ArticleActionSchedule: {
+		from:       []string{ArticleStatusDraft, ArticleStatusInReview, ArticleStatusChangesRequested, ArticleStatusApproved, ArticleStatusPublished, ArticleStatusArchived},
+		to:         ArticleStatusApproved,
+		editorOnly: true,
+	},
+	ArticleActionPublish: {
+		from:       []string{ArticleStatusDraft, ArticleStatusInReview, ArticleStatusChangesRequested, ArticleStatusApproved, ArticleStatusArchived},
+		to:         ArticleStatusPublished,
+		editorOnly: true,
+	},
+	ArticleActionArchive: {
+		from:       []string{ArticleStatusDraft, ArticleStatusInReview, ArticleStatusChangesRequested, ArticleStatusApproved, ArticleStatusPublished},
+		to:         ArticleStatusArchived,
+		editorOnly: true,
+	},
+	ArticleActionRestore: {
+		from:       []string{ArticleStatusArchived},
+		to:         ArticleStatusDraft,
+		editorOnly: true,
+	},
+}
+
+// NextArticleStatus returns the state action moves an article in status
+// from to, or why the actor may not take it
+func NextArticleStatus(from, action string, actor Actor) (string, error) {
+	rule, ok := articleTransitions[action]
+	if !ok {
+		return "", ErrInvalidArticleAction
+	}
+	if !actor.IsWriter() || (rule.editorOnly && !actor.IsEditor()) {
+		return "", ErrTransitionForbidden
+	}
+	for _, status := range rule.from {
+		if status == from {
+			return rule.to, nil
+		}
+	}
+	return "", ErrInvalidTransition
+}
+
+// IsValidArticleStatus reports whether status is one of the workflow states
+func IsValidArticleStatus(status string) bool {
+	switch status {
+	case ArticleStatusDraft, ArticleStatusInReview, ArticleStatusChangesRequested,
+		ArticleStatusApproved, ArticleStatusPublished, ArticleStatusArchived:
+		return true
+	}
+	return false
+}
+
+// ArticleTransition is one step in an article's workflow history, with the
+// reviewer's comment
+type ArticleTransition struct {
+	ID         string    `json:"id" db:"id"`
+	ArticleID  string    `json:"articleId" db:"article_id"`
+	Action     string    `json:"action" db:"action"`
+	FromStatus string    `json:"fromStatus" db:"from_status"`
+	ToStatus   string    `json:"toStatus" db:"to_status"`
+	Comment    string    `json:"comment" db:"comment"`
+	ActorID    string    `json:"actorId" db:"actor_id"`
+	ActorName  string    `json:"actorName" db:"actor_name"`
+	CreatedAt  time.Time `json:"createdAt" db:"created_at"`
+}
+
+type ArticleTransitionRequest struct {
+	Action    string     `json:"action" binding:"required"`
+	Comment   string     `json:"comment"`
+	PublishAt *time.Time `json:"publishAt,omitempty"` // required by schedule
+}
+
+type ArticleWorkflowRepository interface {
+	Record(ctx context.Context, transition *ArticleTransition) error
+	// GetByArticle returns the history of an article, oldest first
+	GetByArticle(ctx context.Context, articleID string) ([]*ArticleTransition, error)
+}
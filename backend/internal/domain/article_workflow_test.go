@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNextArticleStatus(t *testing.T) {
+	writer := Actor{ID: "w", Role: RoleWriter}
+	editor := Actor{ID: "e", Role: RoleEditor}
+	admin := Actor{ID: "a", Role: RoleAdmin}
+
+	tests := []struct {
+		name    string
+		from    string
+		action  string
+		actor   Actor
+		want    string
+		wantErr error
+	}{
+		{"writer submits a draft", ArticleStatusDraft, ArticleActionSubmit, writer, ArticleStatusInReview, nil},
+		{"writer resubmits after changes", ArticleStatusChangesRequested, ArticleActionSubmit, writer, ArticleStatusInReview, nil},
+		{"writer withdraws from review", ArticleStatusInReview, ArticleActionWithdraw, writer, ArticleStatusDraft, nil},
+		{"writer cannot approve", ArticleStatusInReview, ArticleActionApprove, writer, "", ErrTransitionForbidden},
+		{"writer cannot publish", ArticleStatusDraft, ArticleActionPublish, writer, "", ErrTransitionForbidden},
+		{"writer cannot archive", ArticleStatusPublished, ArticleActionArchive, writer, "", ErrTransitionForbidden},
+		{"editor approves", ArticleStatusInReview, ArticleActionApprove, editor, ArticleStatusApproved, nil},
+		{"editor requests changes", ArticleStatusInReview, ArticleActionRequestChanges, editor, ArticleStatusChangesRequested, nil},
+		{"editor requests changes on approved", ArticleStatusApproved, ArticleActionRequestChanges, editor, ArticleStatusChangesRequested, nil},
+		{"editor schedules", ArticleStatusDraft, ArticleActionSchedule, editor, ArticleStatusApproved, nil},
+		{"editor publishes", ArticleStatusApproved, ArticleActionPublish, editor, ArticleStatusPublished, nil},
+		{"admin archives", ArticleStatusPublished, ArticleActionArchive, admin, ArticleStatusArchived, nil},
+		{"admin restores", ArticleStatusArchived, ArticleActionRestore, admin, ArticleStatusDraft, nil},
+		{"cannot approve a draft", ArticleStatusDraft, ArticleActionApprove, editor, "", ErrInvalidTransition},
+		{"cannot republish", ArticleStatusPublished, ArticleActionPublish, editor, "", ErrInvalidTransition},
+		{"cannot archive twice", ArticleStatusArchived, ArticleActionArchive, editor, "", ErrInvalidTransition},
+		{"cannot restore a draft", ArticleStatusDraft, ArticleActionRestore, admin, "", ErrInvalidTransition},
+		{"cannot withdraw a draft", ArticleStatusDraft, ArticleActionWithdraw, writer, "", ErrInvalidTransition},
+		{"unknown action", ArticleStatusDraft, "delete", editor, "", ErrInvalidArticleAction},
+		{"unknown role", ArticleStatusDraft, ArticleActionSubmit, Actor{ID: "v", Role: "viewer"}, "", ErrTransitionForbidden},
+		{"missing role", ArticleStatusDraft, ArticleActionSubmit, Actor{ID: "v"}, "", ErrTransitionForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextArticleStatus(tt.from, tt.action, tt.actor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextArticleStatus(%q, %q, %q) error = %v, want %v", tt.from, tt.action, tt.actor.Role, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NextArticleStatus(%q, %q, %q) = %q, want %q", tt.from, tt.action, tt.actor.Role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActorCanChange(t *testing.T) {
+	article := &Article{Author: Author{ID: "w1"}}
+
+	tests := []struct {
+		name  string
+		actor Actor
+		want  bool
+	}{
+		{"author who is a writer", Actor{ID: "w1", Role: RoleWriter}, true},
+		{"another writer", Actor{ID: "w2", Role: RoleWriter}, false},
+		{"editor", Actor{ID: "e", Role: RoleEditor}, true},
+		{"admin", Actor{ID: "a", Role: RoleAdmin}, true},
+		{"author without a staff role", Actor{ID: "w1", Role: "user"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.actor.CanChange(article); got != tt.want {
+			t.Errorf("%s: CanChange() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAuthorNotFound    = errors.New("author not found")
+	ErrInvalidAuthor     = errors.New("author must be an active admin user")
+	ErrInvalidAuthorSlug = errors.New("author slug must contain letters or digits")
+	ErrInvalidSocialLink = errors.New("social links must be absolute http(s) URLs")
+)
+
+// AuthorProfile is the public page of a user who writes articles. Admin
+// users without a profile yet are listed with an empty slug; one is created
+// when they publish their first article or save their profile.
+type AuthorProfile struct {
+	ID           string            `json:"id"` // user ID
+	Name         string            `json:"name"`
+	Slug         string            `json:"slug"`
+	Bio          string            `json:"bio"`
+	Avatar       string            `json:"avatar"`
+	SocialLinks  map[string]string `json:"socialLinks"` // e.g. "github" -> profile URL
+	ArticleCount int               `json:"articleCount"`
+	UpdatedAt    *time.Time        `json:"updatedAt,omitempty"`
+}
+
+// UpdateAuthorProfileRequest replaces a profile; an empty slug is generated
+// from the author's name
+type UpdateAuthorProfileRequest struct {
+	Slug        string            `json:"slug"`
+	Bio         string            `json:"bio"`
+	Avatar      string            `json:"avatar"`
+	SocialLinks map[string]string `json:"socialLinks"`
+}
+
+type ReassignAuthorRequest struct {
+	AuthorID string `json:"authorId" binding:"required"`
+}
+
+type AuthorRepository interface {
+	// GetAll lists admin users and anyone else with a profile
+	GetAll(ctx context.Context) ([]*AuthorProfile, error)
+	// GetByID returns ErrAuthorNotFound unless the user is an active admin
+	// or already has a profile
+	GetByID(ctx context.Context, userID string) (*AuthorProfile, error)
+	GetBySlug(ctx context.Context, slug string) (*AuthorProfile, error)
+	// SaveProfile creates or replaces the profile of profile.ID; it returns
+	// ErrSlugAlreadyExists when another author has the slug
+	SaveProfile(ctx context.Context, profile *AuthorProfile) error
+	// AssignArticle makes authorID the author of an article
+	AssignArticle(ctx context.Context, articleID, authorID string) error
+}
+
+type AuthorUsecase interface {
+	GetAuthors(ctx context.Context) ([]*AuthorProfile, error)
+	GetAuthor(ctx context.Context, userID string) (*AuthorProfile, error)
+	UpdateProfile(ctx context.Context, userID string, req UpdateAuthorProfileRequest) (*AuthorProfile, error)
+	// GetAuthorPage returns a public profile with a page of its published articles
+	GetAuthorPage(ctx context.Context, slug string, page, limit int) (*AuthorProfile, *ArticleListResult, error)
+	ReassignArticle(ctx context.Context, articleID, authorID string) (*Article, error)
+}
+
+// NormalizeSocialLinks drops empty entries, lowercases network names and
+// rejects anything that is not an absolute http(s) URL
+func NormalizeSocialLinks(links map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(links))
+	for name, link := range links {
+		name = strings.ToLower(strings.TrimSpace(name))
+		link = strings.TrimSpace(link)
+		if name == "" || link == "" {
+			continue
+		}
+
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, ErrInvalidSocialLink
+		}
+		normalized[name] = link
+	}
+	return normalized, nil
+}
@@ -0,0 +1,120 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Batch actions. Each content type supports a subset of them.
+const (
+	ActionPublish        = "publish"
+	ActionUnpublish      = "unpublish"
+	ActionFeature        = "feature"
+	ActionUnfeature      = "unfeature"
+	ActionChangeCategory = "change_category"
+	ActionAddTag         = "add_tag"
+	ActionRemoveTag      = "remove_tag"
+	ActionDelete         = "delete"
+)
+
+// Outcomes of one item in a batch
+const (
+	ItemUpdated   = "updated"
+	ItemUnchanged = "unchanged" // already in the requested state
+	ItemFailed    = "failed"
+)
+
+// MaxItems caps the size of one batch
+const MaxItems = 100
+
+var (
+	ErrUnsupportedAction = errors.New("action is not supported for this content")
+	ErrNoItems           = errors.New("ids must list at least one item")
+	ErrTooManyItems      = fmt.Errorf("a batch may hold at most %d items", MaxItems)
+	ErrValueRequired     = errors.New("change_category, add_tag and remove_tag need a value")
+)
+
+type Request struct {
+	IDs    []string `json:"ids" binding:"required"`
+	Action string   `json:"action" binding:"required"`
+	Value  string   `json:"value,omitempty"` // category for change_category, tag for add_tag and remove_tag
+}
+
+// Normalize trims and de-duplicates the IDs and checks the request against
+// the actions the content supports
+func (r *Request) Normalize(supported ...string) error {
+	ok := false
+	for _, action := range supported {
+		if r.Action == action {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return ErrUnsupportedAction
+	}
+
+	r.Value = strings.TrimSpace(r.Value)
+	switch r.Action {
+	case ActionChangeCategory, ActionAddTag, ActionRemoveTag:
+		if r.Value == "" {
+			return ErrValueRequired
+		}
+	}
+
+	seen := make(map[string]bool, len(r.IDs))
+	ids := make([]string, 0, len(r.IDs))
+	for _, id := range r.IDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return ErrNoItems
+	}
+	if len(ids) > MaxItems {
+		return ErrTooManyItems
+	}
+	r.IDs = ids
+
+	return nil
+}
+
+type ItemResult struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the outcome of a batch. A batch runs in one transaction and is
+// all or nothing: when any item fails, nothing is saved and Applied is false.
+type Report struct {
+	Action    string       `json:"action"`
+	Applied   bool         `json:"applied"`
+	Updated   int          `json:"updated"`
+	Unchanged int          `json:"unchanged"`
+	Failed    int          `json:"failed"`
+	Items     []ItemResult `json:"items"`
+}
+
+// Add records the outcome of one item
+func (r *Report) Add(id string, changed bool, err error) {
+	item := ItemResult{ID: id}
+	switch {
+	case err != nil:
+		item.Status = ItemFailed
+		item.Error = err.Error()
+		r.Failed++
+	case changed:
+		item.Status = ItemUpdated
+		r.Updated++
+	default:
+		item.Status = ItemUnchanged
+		r.Unchanged++
+	}
+	r.Items = append(r.Items, item)
+}
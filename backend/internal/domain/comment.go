@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Comment moderation states
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+)
+
+// Comment is a customer comment on an article; replies point at their parent
+type Comment struct {
+	ID           string     `json:"id"`
+	ArticleID    string     `json:"articleId"`
+	ArticleTitle string     `json:"articleTitle,omitempty"`
+	ParentID     string     `json:"parentId,omitempty"`
+	UserID       string     `json:"userId"`
+	AuthorName   string     `json:"authorName"`
+	Body         string     `json:"body"`
+	Status       string     `json:"status"`
+	SpamScore    int        `json:"spamScore"`
+	SpamReasons  []string   `json:"spamReasons,omitempty"`
+	ModeratedBy  string     `json:"moderatedBy,omitempty"`
+	ModeratedAt  *time.Time `json:"moderatedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	Replies      []*Comment `json:"replies,omitempty"`
+}
+
+type CreateCommentRequest struct {
+	Body     string `json:"body" binding:"required"`
+	ParentID string `json:"parentId,omitempty"`
+}
+
+type ModerateCommentRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type CommentListParams struct {
+	Status    string `json:"status,omitempty"`
+	ArticleID string `json:"articleId,omitempty"`
+	Page      int    `json:"page"`
+	Limit     int    `json:"limit"`
+}
+
+type CommentListResult struct {
+	Comments   []*Comment `json:"comments"`
+	Total      int        `json:"total"`
+	Page       int        `json:"page"`
+	TotalPages int        `json:"totalPages"`
+}
+
+// Comment limits. A customer may post CommentRateLimit comments per
+// CommentRateWindow, at least CommentMinInterval apart.
+const (
+	CommentMaxLength       = 5000
+	CommentRateLimit       = 5
+	CommentRateWindow      = 10 * time.Minute
+	CommentMinInterval     = 15 * time.Second
+	CommentSpamRejectScore = 5 // comments scoring this or more skip the queue as rejected
+)
+
+var (
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrCommentEmpty         = errors.New("comment body is required")
+	ErrCommentTooLong       = errors.New("comment is too long")
+	ErrCommentRateLimited   = errors.New("too many comments, please wait before posting again")
+	ErrInvalidCommentParent = errors.New("parent comment must be an approved comment on the same article")
+	ErrInvalidCommentStatus = errors.New("status must be pending, approved or rejected")
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) error
+	GetByID(ctx context.Context, id string) (*Comment, error)
+	GetApprovedByArticle(ctx context.Context, articleID string) ([]*Comment, error)
+	List(ctx context.Context, params CommentListParams) (*CommentListResult, error)
+	// GetRecentByUser returns a user's comments since the given time, newest first
+	GetRecentByUser(ctx context.Context, userID string, since time.Time) ([]*Comment, error)
+	UpdateStatus(ctx context.Context, id, status, moderatorID string) error
+	Delete(ctx context.Context, id string) error
+}
+
+type CommentUsecase interface {
+	GetArticleComments(ctx context.Context, slug string) ([]*Comment, error)
+	CreateComment(ctx context.Context, slug, userID string, req CreateCommentRequest) (*Comment, error)
+	ListComments(ctx context.Context, params CommentListParams) (*CommentListResult, error)
+	ModerateComment(ctx context.Context, id, status, moderatorID string) (*Comment, error)
+	DeleteComment(ctx context.Context, id string) error
+}
+
+// IsValidCommentStatus reports whether status is a moderation state
+func IsValidCommentStatus(status string) bool {
+	switch status {
+	case CommentStatusPending, CommentStatusApproved, CommentStatusRejected:
+		return true
+	}
+	return false
+}
+
+var (
+	commentLinkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+	commentSpamPhrases = []string{
+		"buy now", "click here", "free money", "casino", "viagra", "crypto giveaway",
+		"work from home", "earn $", "limited offer", "seo services", "judi online", "slot gacor",
+	}
+)
+
+// ScoreCommentSpam applies simple spam heuristics to a comment body and
+// returns a score with the reasons that contributed to it
+func ScoreCommentSpam(body string) (int, []string) {
+	score := 0
+	var reasons []string
+	lower := strings.ToLower(body)
+
+	if links := len(commentLinkPattern.FindAllString(body, -1)); links > 0 {
+		score += links
+		if links > 2 {
+			score += 2
+		}
+		reasons = append(reasons, "contains links")
+	}
+
+	for _, phrase := range commentSpamPhrases {
+		if strings.Contains(lower, phrase) {
+			score += 3
+			reasons = append(reasons, "spam phrase: "+phrase)
+		}
+	}
+
+	letters, upper := 0, 0
+	for _, r := range body {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters >= 20 && upper*10 >= letters*7 {
+		score += 2
+		reasons = append(reasons, "mostly capital letters")
+	}
+
+	if hasLongRun(body, 8) {
+		score += 1
+		reasons = append(reasons, "repeated characters")
+	}
+
+	return score, reasons
+}
+
+// hasLongRun reports whether s repeats one character at least n times in a row
+func hasLongRun(s string, n int) bool {
+	var last rune
+	run := 0
+	for _, r := range s {
+		if r == last {
+			run++
+		} else {
+			last, run = r, 1
+		}
+		if run >= n {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildCommentTree nests replies under their parents, keeping the input
+// order at each level. Replies whose parent is missing are dropped.
+func BuildCommentTree(comments []*Comment) []*Comment {
+	byID := make(map[string]*Comment, len(comments))
+	for _, comment := range comments {
+		comment.Replies = nil
+		byID[comment.ID] = comment
+	}
+
+	roots := []*Comment{}
+	for _, comment := range comments {
+		if comment.ParentID == "" {
+			roots = append(roots, comment)
+			continue
+		}
+		if parent, ok := byID[comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, comment)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// Severities of a lint issue. Errors block content from going public, by
+// publishing, scheduling or editing a live article; warnings are only
+// reported. Drafts are saved either way.
+const (
+	LintSeverityWarning = "warning"
+	LintSeverityError   = "error"
+)
+
+// MaxParagraphWords is the length above which a paragraph is reported as too long
+const MaxParagraphWords = 150
+
+// LintIssue is one problem found in an article's content. Block is the index
+// of the EditorJS block it was found in.
+type LintIssue struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Block    int    `json:"block"`
+	BlockID  string `json:"blockId,omitempty"`
+	Message  string `json:"message"`
+}
+
+// LintReport lists the issues of one document in block order
+type LintReport struct {
+	Issues   []LintIssue `json:"issues"`
+	Errors   int         `json:"errors"`
+	Warnings int         `json:"warnings"`
+}
+
+// HasErrors reports whether any issue blocks publishing
+func (r *LintReport) HasErrors() bool {
+	return r.Errors > 0
+}
+
+// ContentLintError is returned when content with blocking lint issues
+// would go public. Nothing is saved; the report lists every issue.
+type ContentLintError struct {
+	Report *LintReport
+}
+
+func (e *ContentLintError) Error() string {
+	return fmt.Sprintf("content has %d blocking issue(s)", e.Report.Errors)
+}
+
+// AsContentLintError returns the *ContentLintError in err's chain, if any
+func AsContentLintError(err error) (*ContentLintError, bool) {
+	var lintErr *ContentLintError
+	if errors.As(err, &lintErr) {
+		return lintErr, true
+	}
+	return nil, false
+}
+
+// LintRule checks the blocks of a document. Rules are independent of each
+// other, so a deployment can add its own or drop the defaults.
+type LintRule interface {
+	// Name identifies the rule in reported issues
+	Name() string
+	// Check returns the issues of one block, with Rule and Block left for
+	// the linter to fill in
+	Check(block EditorJSBlock) []LintIssue
+}
+
+// ContentLinter runs a set of rules over EditorJS content
+type ContentLinter struct {
+	rules []LintRule
+}
+
+// NewContentLinter creates a linter with the given rules, or with
+// DefaultLintRules when none are given
+func NewContentLinter(rules ...LintRule) *ContentLinter {
+	if len(rules) == 0 {
+		rules = DefaultLintRules()
+	}
+	return &ContentLinter{rules: rules}
+}
+
+// DefaultLintRules returns the rules articles are checked against
+func DefaultLintRules() []LintRule {
+	return []LintRule{
+		imageAltRule{},
+		emptyHeaderRule{},
+		longParagraphRule{maxWords: MaxParagraphWords},
+		localLinkRule{},
+	}
+}
+
+// Lint checks stored article content. Legacy plain-text content is checked
+// as a single paragraph.
+func (l *ContentLinter) Lint(content string) *LintReport {
+	report := &LintReport{Issues: []LintIssue{}}
+	for i, block := range ParseEditorJS(content).Blocks {
+		for _, rule := range l.rules {
+			for _, issue := range rule.Check(block) {
+				issue.Rule = rule.Name()
+				issue.Block = i
+				issue.BlockID = block.ID
+				if issue.Severity == LintSeverityError {
+					report.Errors++
+				} else {
+					issue.Severity = LintSeverityWarning
+					report.Warnings++
+				}
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+	return report
+}
+
+// imageAltRule reports images without a caption or alt text, which leaves
+// screen readers with nothing to read
+type imageAltRule struct{}
+
+func (imageAltRule) Name() string { return "image-alt" }
+
+func (imageAltRule) Check(block EditorJSBlock) []LintIssue {
+	if block.Type != "image" {
+		return nil
+	}
+	data := block.data()
+	if data.imageURL() == "" {
+		return []LintIssue{{Severity: LintSeverityWarning, Message: "image has no URL and is not shown"}}
+	}
+	if StripHTML(data.Caption) == "" && strings.TrimSpace(data.Alt) == "" {
+		return []LintIssue{{Severity: LintSeverityWarning, Message: "image has no caption or alt text"}}
+	}
+	return nil
+}
+
+// emptyHeaderRule reports headers without text
+type emptyHeaderRule struct{}
+
+func (emptyHeaderRule) Name() string { return "empty-header" }
+
+func (emptyHeaderRule) Check(block EditorJSBlock) []LintIssue {
+	if block.Type != "header" || StripHTML(block.data().Text) != "" {
+		return nil
+	}
+	return []LintIssue{{Severity: LintSeverityWarning, Message: "header is empty"}}
+}
+
+// longParagraphRule reports paragraphs that are hard to read on screen
+type longParagraphRule struct {
+	maxWords int
+}
+
+func (longParagraphRule) Name() string { return "long-paragraph" }
+
+func (r longParagraphRule) Check(block EditorJSBlock) []LintIssue {
+	if block.Type != "paragraph" {
+		return nil
+	}
+	words := len(strings.Fields(StripHTML(block.data().Text)))
+	if words <= r.maxWords {
+		return nil
+	}
+	return []LintIssue{{
+		Severity: LintSeverityWarning,
+		Message:  fmt.Sprintf("paragraph has %d words; consider splitting it (at most %d)", words, r.maxWords),
+	}}
+}
+
+// localLinkRule blocks links and images pointing at localhost or private
+// addresses, which only work on the author's machine
+type localLinkRule struct{}
+
+func (localLinkRule) Name() string { return "local-link" }
+
+func (localLinkRule) Check(block EditorJSBlock) []LintIssue {
+	data := block.data()
+	var links []string
+	switch block.Type {
+	case "header", "paragraph":
+		links = inlineLinks(data.Text)
+	case "list":
+		for _, text := range flattenListHTML(data.listItems()) {
+			links = append(links, inlineLinks(text)...)
+		}
+	case "image":
+		links = append(links, data.imageURL())
+		links = append(links, inlineLinks(data.Caption)...)
+	}
+
+	var issues []LintIssue
+	for _, link := range links {
+		if isLocalURL(link) {
+			issues = append(issues, LintIssue{
+				Severity: LintSeverityError,
+				Message:  fmt.Sprintf("%s points at a local address", link),
+			})
+		}
+	}
+	return issues
+}
+
+// inlineLinks returns the href of every link in an inline HTML fragment
+func inlineLinks(s string) []string {
+	var links []string
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case nethtml.ErrorToken:
+			return links
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "a" {
+				continue
+			}
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				if string(key) == "href" {
+					links = append(links, string(val))
+				}
+			}
+		}
+	}
+}
+
+func flattenListHTML(items []editorJSListItem) []string {
+	var texts []string
+	for _, item := range items {
+		texts = append(texts, item.Content)
+		texts = append(texts, flattenListHTML(item.Items)...)
+	}
+	return texts
+}
+
+// isLocalURL reports whether u is an absolute URL on localhost, a .local
+// or .localhost name, a loopback or private IP, or a file: URL
+func isLocalURL(u string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(parsed.Scheme, "file") {
+		return true
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return false
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".local") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast())
+}
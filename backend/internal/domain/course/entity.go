@@ -2,23 +2,31 @@ package course
 
 import (
 	"context"
+	"errors"
 	"time"
+
+	"portfolio/internal/domain/bulk"
+	"portfolio/internal/domain/pagination"
 )
 
+var ErrCourseNotFound = errors.New("course not found")
+
 // Course represents an online course
 type Course struct {
-	ID           string    `json:"id" db:"id"`
-	Title        string    `json:"title" db:"title"`
-	Slug         string    `json:"slug" db:"slug"`
-	Description  string    `json:"description" db:"description"`
-	Thumbnail    string    `json:"thumbnail" db:"thumbnail"`
-	Price        float64   `json:"price" db:"price"`
-	IsFree       bool      `json:"is_free" db:"is_free"`
-	Level        string    `json:"level" db:"level"`   // beginner, intermediate, advanced
-	Status       string    `json:"status" db:"status"` // draft, published
-	InstructorID string    `json:"instructor_id" db:"instructor_id"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID           string     `json:"id" db:"id"`
+	Title        string     `json:"title" db:"title"`
+	Slug         string     `json:"slug" db:"slug"`
+	Description  string     `json:"description" db:"description"`
+	Thumbnail    string     `json:"thumbnail" db:"thumbnail"`
+	Price        float64    `json:"price" db:"price"`
+	IsFree       bool       `json:"is_free" db:"is_free"`
+	Level        string     `json:"level" db:"level"`   // beginner, intermediate, advanced
+	Status       string     `json:"status" db:"status"` // draft, published
+	InstructorID string     `json:"instructor_id" db:"instructor_id"`
+	PublishAt    *time.Time `json:"publish_at,omitempty" db:"publish_at"`     // scheduled go-live time
+	UnpublishAt  *time.Time `json:"unpublish_at,omitempty" db:"unpublish_at"` // scheduled take-down time
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
 
 	// Relations (not in DB)
 	Sections      []Section   `json:"sections,omitempty" db:"-"`
@@ -28,6 +36,12 @@ type Course struct {
 	IsEnrolled    bool        `json:"is_enrolled" db:"-"`
 }
 
+// IsPublic reports whether the public may see the course the same way the
+// repository does: published, and not past its unpublish time
+func (c *Course) IsPublic(now time.Time) bool {
+	return c.Status == "published" && (c.UnpublishAt == nil || c.UnpublishAt.After(now))
+}
+
 // Section represents a course section/module
 type Section struct {
 	ID          string    `json:"id" db:"id"`
@@ -102,6 +116,9 @@ type CreateCourseRequest struct {
 	IsFree      bool    `json:"is_free"`
 	Level       string  `json:"level"`
 	Status      string  `json:"status"`
+
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
 }
 
 type UpdateCourseRequest struct {
@@ -112,6 +129,10 @@ type UpdateCourseRequest struct {
 	IsFree      *bool    `json:"is_free,omitempty"`
 	Level       *string  `json:"level,omitempty"`
 	Status      *string  `json:"status,omitempty"`
+
+	PublishAt     *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt   *time.Time `json:"unpublish_at,omitempty"`
+	ClearSchedule bool       `json:"clear_schedule,omitempty"` // removes publish_at and unpublish_at
 }
 
 type CreateSectionRequest struct {
@@ -139,6 +160,9 @@ type CourseListParams struct {
 	IsFree     *bool  `form:"is_free"`
 	Instructor string `form:"instructor"`
 	Search     string `form:"search"`
+
+	// Cursor switches the public list to keyset pagination, newest first
+	Cursor *pagination.Cursor `form:"-"`
 }
 
 type CourseListResponse struct {
@@ -146,6 +170,7 @@ type CourseListResponse struct {
 	Total      int       `json:"total"`
 	Page       int       `json:"page"`
 	TotalPages int       `json:"total_pages"`
+	NextCursor string    `json:"next_cursor,omitempty"` // keyset mode only; empty on the last page
 }
 
 // Repository interface
@@ -158,6 +183,8 @@ type Repository interface {
 	GetAllCourses(ctx context.Context, params CourseListParams) (*CourseListResponse, error) // Admin only - includes drafts
 	UpdateCourse(ctx context.Context, id string, updates UpdateCourseRequest) error
 	DeleteCourse(ctx context.Context, id string) error
+	ApplyPublishSchedule(ctx context.Context, now time.Time) (published int, unpublished int, err error)
+	BulkUpdate(ctx context.Context, req bulk.Request) (*bulk.Report, error) // publish, unpublish or delete
 
 	// Section operations
 	CreateSection(ctx context.Context, section *Section) error
@@ -194,6 +221,7 @@ type Usecase interface {
 	GetAllCourses(ctx context.Context, params CourseListParams) (*CourseListResponse, error) // Admin only - includes drafts
 	UpdateCourse(ctx context.Context, id string, req UpdateCourseRequest) error
 	DeleteCourse(ctx context.Context, id string) error
+	BulkCourses(ctx context.Context, req bulk.Request) (*bulk.Report, error)
 
 	// Curriculum building
 	CreateSection(ctx context.Context, req CreateSectionRequest) (*Section, error)
@@ -0,0 +1,571 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// Content formats supported by the EditorJS renderer
+const (
+	ContentFormatEditorJS = "editorjs"
+	ContentFormatHTML     = "html"
+	ContentFormatMarkdown = "markdown"
+	ContentFormatText     = "text"
+)
+
+// EditorJSDocument is the JSON document saved by the admin editor
+type EditorJSDocument struct {
+	Time    int64           `json:"time,omitempty"`
+	Blocks  []EditorJSBlock `json:"blocks"`
+	Version string          `json:"version,omitempty"`
+}
+
+// EditorJSBlock is a single block inside an EditorJS document
+type EditorJSBlock struct {
+	ID   string          `json:"id,omitempty"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type editorJSBlockData struct {
+	Text     string            `json:"text"`
+	Level    int               `json:"level"`
+	Style    string            `json:"style"`
+	Items    []json.RawMessage `json:"items"`
+	Code     string            `json:"code"`
+	Language string            `json:"language"`
+	Caption  string            `json:"caption"`
+	Alt      string            `json:"alt"`
+	URL      string            `json:"url"`
+	File     struct {
+		URL string `json:"url"`
+	} `json:"file"`
+}
+
+type editorJSListItem struct {
+	Content string
+	Checked bool // checklist items only
+	Items   []editorJSListItem
+}
+
+// ParseEditorJS parses stored article content. Content that is not an
+// EditorJS document (legacy plain text) is wrapped in a single paragraph.
+func ParseEditorJS(content string) *EditorJSDocument {
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return &EditorJSDocument{Blocks: []EditorJSBlock{}}
+	}
+
+	var doc EditorJSDocument
+	if strings.HasPrefix(trimmed, "{") && json.Unmarshal([]byte(trimmed), &doc) == nil && doc.Blocks != nil {
+		return &doc
+	}
+
+	data, _ := json.Marshal(map[string]string{"text": html.EscapeString(trimmed)})
+	return &EditorJSDocument{Blocks: []EditorJSBlock{{Type: "paragraph", Data: data}}}
+}
+
+// RenderContent renders stored article content in the requested format
+func RenderContent(content, format string) (string, error) {
+	switch format {
+	case "", ContentFormatEditorJS:
+		return content, nil
+	case ContentFormatHTML:
+		return ParseEditorJS(content).HTML(), nil
+	case ContentFormatMarkdown:
+		return ParseEditorJS(content).Markdown(), nil
+	case ContentFormatText:
+		return ParseEditorJS(content).PlainText(), nil
+	default:
+		return "", fmt.Errorf("unsupported content format: %s", format)
+	}
+}
+
+// HTML renders the document as sanitized HTML
+func (d *EditorJSDocument) HTML() string {
+	var b strings.Builder
+	for _, block := range d.Blocks {
+		data := block.data()
+		switch block.Type {
+		case "header":
+			level := data.Level
+			if level < 1 || level > 6 {
+				level = 2
+			}
+			fmt.Fprintf(&b, "<h%d>%s</h%d>\n", level, SanitizeInlineHTML(data.Text), level)
+		case "paragraph":
+			fmt.Fprintf(&b, "<p>%s</p>\n", SanitizeInlineHTML(data.Text))
+		case "list":
+			writeHTMLList(&b, data.Style, data.listItems())
+		case "code":
+			class := ""
+			if data.Language != "" {
+				class = fmt.Sprintf(` class="language-%s"`, html.EscapeString(data.Language))
+			}
+			fmt.Fprintf(&b, "<pre><code%s>%s</code></pre>\n", class, html.EscapeString(data.Code))
+		case "image":
+			src := data.imageURL()
+			if !isSafeURL(src) {
+				continue
+			}
+			caption := SanitizeInlineHTML(data.Caption)
+			alt := strings.TrimSpace(data.Alt)
+			if alt == "" {
+				alt = StripHTML(data.Caption)
+			}
+			fmt.Fprintf(&b, `<figure><img src="%s" alt="%s">`, html.EscapeString(src), html.EscapeString(alt))
+			if caption != "" {
+				fmt.Fprintf(&b, "<figcaption>%s</figcaption>", caption)
+			}
+			b.WriteString("</figure>\n")
+		}
+	}
+	return b.String()
+}
+
+// Markdown renders the document as Markdown. Text is escaped so that
+// ParseMarkdown reads the output back into the same blocks.
+func (d *EditorJSDocument) Markdown() string {
+	var parts []string
+	lastListDelimiter := ""
+	for _, block := range d.Blocks {
+		data := block.data()
+		switch block.Type {
+		case "header":
+			level := data.Level
+			if level < 1 || level > 6 {
+				level = 2
+			}
+			text := inlineToMarkdown(data.Text)
+			if strings.HasSuffix(text, "#") {
+				text = text[:len(text)-1] + "\\#"
+			}
+			parts = append(parts, strings.Repeat("#", level)+" "+text)
+		case "paragraph":
+			parts = append(parts, escapeMarkdownLineStart(inlineToMarkdown(data.Text)))
+		case "list":
+			// Adjacent lists are told apart by switching the marker
+			delimiter := "-"
+			if data.Style == "ordered" {
+				delimiter = "."
+			}
+			if lastListDelimiter == delimiter {
+				delimiter = map[string]string{"-": "*", ".": ")"}[delimiter]
+			}
+			var b strings.Builder
+			writeMarkdownList(&b, data.Style, delimiter, data.listItems(), "")
+			parts = append(parts, strings.TrimRight(b.String(), "\n"))
+			lastListDelimiter = delimiter
+			continue
+		case "code":
+			fence := strings.Repeat("`", max(3, longestRun(data.Code, '`')+1))
+			parts = append(parts, fence+data.Language+"\n"+data.Code+"\n"+fence)
+		case "image":
+			src := data.imageURL()
+			if src == "" {
+				continue
+			}
+			if strings.ContainsAny(src, " ()") {
+				src = "<" + src + ">"
+			}
+			parts = append(parts, fmt.Sprintf("![%s](%s)", escapeMarkdownText(StripHTML(data.Caption)), src))
+		}
+		lastListDelimiter = ""
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// PlainText renders the document as plain text, one block per line
+func (d *EditorJSDocument) PlainText() string {
+	var lines []string
+	for _, block := range d.Blocks {
+		data := block.data()
+		switch block.Type {
+		case "header", "paragraph":
+			lines = append(lines, StripHTML(data.Text))
+		case "list":
+			lines = append(lines, flattenListText(data.listItems())...)
+		case "code":
+			lines = append(lines, data.Code)
+		case "image":
+			lines = append(lines, StripHTML(data.Caption))
+		}
+	}
+
+	nonEmpty := lines[:0]
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			nonEmpty = append(nonEmpty, strings.TrimSpace(line))
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// SanitizeInlineHTML keeps the inline formatting tags EditorJS produces and
+// drops everything else, including attributes other than a safe link href.
+func SanitizeInlineHTML(s string) string {
+	var b strings.Builder
+	var open []string
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		switch tt {
+		case nethtml.ErrorToken:
+			for i := len(open) - 1; i >= 0; i-- {
+				b.WriteString("</" + open[i] + ">")
+			}
+			return b.String()
+		case nethtml.TextToken:
+			b.WriteString(html.EscapeString(html.UnescapeString(string(z.Text()))))
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			tag := string(name)
+			if tag == "br" {
+				b.WriteString("<br>")
+				continue
+			}
+			if !allowedInlineTags[tag] {
+				continue
+			}
+			if tag == "a" {
+				href := ""
+				for hasAttr {
+					var key, val []byte
+					key, val, hasAttr = z.TagAttr()
+					if string(key) == "href" {
+						href = string(val)
+					}
+				}
+				if isSafeURL(href) {
+					fmt.Fprintf(&b, `<a href="%s" rel="noopener noreferrer">`, html.EscapeString(href))
+				} else {
+					b.WriteString("<a>")
+				}
+			} else {
+				b.WriteString("<" + tag + ">")
+			}
+			if tt == nethtml.StartTagToken {
+				open = append(open, tag)
+			} else {
+				b.WriteString("</" + tag + ">")
+			}
+		case nethtml.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == tag {
+					for j := len(open) - 1; j >= i; j-- {
+						b.WriteString("</" + open[j] + ">")
+					}
+					open = open[:i]
+					break
+				}
+			}
+		}
+	}
+}
+
+// StripHTML removes all tags from an inline HTML fragment and unescapes entities
+func StripHTML(s string) string {
+	var b strings.Builder
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case nethtml.ErrorToken:
+			return strings.TrimSpace(b.String())
+		case nethtml.TextToken:
+			b.WriteString(html.UnescapeString(string(z.Text())))
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if name, _ := z.TagName(); string(name) == "br" {
+				b.WriteString(" ")
+			}
+		}
+	}
+}
+
+var allowedInlineTags = map[string]bool{
+	"a": true, "b": true, "strong": true, "i": true, "em": true,
+	"u": true, "s": true, "code": true, "mark": true, "sub": true, "sup": true,
+}
+
+// isSafeURL allows http(s) and mailto links, same-site paths and fragments.
+// "//host" and "/\host" are protocol-relative to browsers, so they are not
+// treated as paths.
+func isSafeURL(u string) bool {
+	lower := strings.ToLower(strings.TrimSpace(u))
+	if strings.HasPrefix(lower, "//") || strings.HasPrefix(lower, "/\\") {
+		return false
+	}
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") ||
+		strings.HasPrefix(lower, "mailto:") || strings.HasPrefix(lower, "/") || strings.HasPrefix(lower, "#")
+}
+
+func (b EditorJSBlock) data() editorJSBlockData {
+	var data editorJSBlockData
+	_ = json.Unmarshal(b.Data, &data)
+	return data
+}
+
+func (d editorJSBlockData) imageURL() string {
+	if d.File.URL != "" {
+		return d.File.URL
+	}
+	return d.URL
+}
+
+// listItems supports both the flat list tool (string items) and the nested
+// list tool ({content, items} objects).
+func (d editorJSBlockData) listItems() []editorJSListItem {
+	return parseListItems(d.Items)
+}
+
+func parseListItems(raw []json.RawMessage) []editorJSListItem {
+	items := make([]editorJSListItem, 0, len(raw))
+	for _, r := range raw {
+		var text string
+		if json.Unmarshal(r, &text) == nil {
+			items = append(items, editorJSListItem{Content: text})
+			continue
+		}
+		var nested struct {
+			Content string `json:"content"`
+			Meta    struct {
+				Checked bool `json:"checked"`
+			} `json:"meta"`
+			Items []json.RawMessage `json:"items"`
+		}
+		if json.Unmarshal(r, &nested) == nil {
+			items = append(items, editorJSListItem{
+				Content: nested.Content,
+				Checked: nested.Meta.Checked,
+				Items:   parseListItems(nested.Items),
+			})
+		}
+	}
+	return items
+}
+
+func writeHTMLList(b *strings.Builder, style string, items []editorJSListItem) {
+	tag := "ul"
+	if style == "ordered" {
+		tag = "ol"
+	}
+	b.WriteString("<" + tag + ">")
+	for _, item := range items {
+		b.WriteString("<li>" + SanitizeInlineHTML(item.Content))
+		if len(item.Items) > 0 {
+			writeHTMLList(b, style, item.Items)
+		}
+		b.WriteString("</li>")
+	}
+	b.WriteString("</" + tag + ">\n")
+}
+
+func writeMarkdownList(b *strings.Builder, style, delimiter string, items []editorJSListItem, indent string) {
+	for i, item := range items {
+		marker := delimiter
+		if style == "ordered" {
+			marker = fmt.Sprintf("%d%s", i+1, delimiter)
+		}
+		if style == "checklist" {
+			check := " "
+			if item.Checked {
+				check = "x"
+			}
+			marker += " [" + check + "]"
+		}
+		fmt.Fprintf(b, "%s%s %s\n", indent, marker, escapeMarkdownLineStart(inlineToMarkdown(item.Content)))
+
+		// Nested items line up with the text of their parent
+		nestedDelimiter := "-"
+		if style == "ordered" {
+			nestedDelimiter = "."
+		}
+		width := len(marker) + 1
+		if style == "checklist" {
+			width = len(delimiter) + 1
+		}
+		writeMarkdownList(b, style, nestedDelimiter, item.Items, indent+strings.Repeat(" ", width))
+	}
+}
+
+func flattenListText(items []editorJSListItem) []string {
+	var lines []string
+	for _, item := range items {
+		lines = append(lines, StripHTML(item.Content))
+		lines = append(lines, flattenListText(item.Items)...)
+	}
+	return lines
+}
+
+// inlineToMarkdown converts the inline tags EditorJS produces to Markdown.
+// Tags without a Markdown form (underline, marker, sub- and superscript)
+// are kept as raw HTML; text is escaped so it cannot be read as markup.
+func inlineToMarkdown(s string) string {
+	var out []byte
+	var hrefs []string
+	inCode := false
+	justOpened := ""
+
+	// Emphasis markers must hug the text they wrap, so surrounding spaces
+	// are moved outside them
+	open := func(marker string) {
+		out = append(out, marker...)
+		justOpened = marker
+	}
+	closeMarker := func(marker string) {
+		trimmed := strings.TrimRight(string(out), " ")
+		spaces := len(out) - len(trimmed)
+		out = append(append([]byte(trimmed), marker...), strings.Repeat(" ", spaces)...)
+	}
+
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		opened := justOpened
+		justOpened = ""
+		switch tt {
+		case nethtml.ErrorToken:
+			return strings.TrimSpace(string(out))
+		case nethtml.TextToken:
+			// The tokenizer has already unescaped entities
+			text := strings.ReplaceAll(string(z.Text()), "\n", " ")
+			if inCode {
+				out = append(out, text...)
+				continue
+			}
+			text = escapeMarkdownText(text)
+			if opened != "" {
+				if trimmed := strings.TrimLeft(text, " "); trimmed != text {
+					out = append(out[:len(out)-len(opened)], text[:len(text)-len(trimmed)]...)
+					out = append(out, opened...)
+					text = trimmed
+				}
+			}
+			out = append(out, text...)
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch tag := string(name); tag {
+			case "b", "strong":
+				open("**")
+			case "i", "em":
+				open("*")
+			case "s":
+				open("~~")
+			case "code":
+				// Code spans are fenced after the fact, once the content is known
+				out = append(out, 0)
+				inCode = true
+			case "u", "mark", "sub", "sup":
+				out = append(out, "<"+tag+">"...)
+			case "br":
+				out = append(out, "<br>"...)
+			case "a":
+				href := ""
+				for hasAttr {
+					var key, val []byte
+					key, val, hasAttr = z.TagAttr()
+					if string(key) == "href" {
+						href = string(val)
+					}
+				}
+				hrefs = append(hrefs, href)
+				out = append(out, '[')
+			}
+		case nethtml.EndTagToken:
+			name, _ := z.TagName()
+			switch tag := string(name); tag {
+			case "b", "strong":
+				closeMarker("**")
+			case "i", "em":
+				closeMarker("*")
+			case "s":
+				closeMarker("~~")
+			case "code":
+				if !inCode {
+					continue
+				}
+				inCode = false
+				start := strings.LastIndexByte(string(out), 0)
+				out = append(out[:start], codeSpan(string(out[start+1:]))...)
+			case "u", "mark", "sub", "sup":
+				out = append(out, "</"+tag+">"...)
+			case "a":
+				href := ""
+				if len(hrefs) > 0 {
+					href = hrefs[len(hrefs)-1]
+					hrefs = hrefs[:len(hrefs)-1]
+				}
+				if strings.ContainsAny(href, " ()") {
+					href = "<" + href + ">"
+				}
+				out = append(out, "]("+href+")"...)
+			}
+		}
+	}
+}
+
+// codeSpan wraps code in a backtick fence longer than any run inside it
+func codeSpan(code string) string {
+	fence := strings.Repeat("`", longestRun(code, '`')+1)
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		code = " " + code + " "
+	}
+	return fence + code + fence
+}
+
+func longestRun(s string, c byte) int {
+	longest, run := 0, 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != c {
+			run = 0
+			continue
+		}
+		run++
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+var markdownTextEscaper = strings.NewReplacer(
+	"\\", "\\\\", "`", "\\`", "*", "\\*", "_", "\\_",
+	"[", "\\[", "]", "\\]", "~", "\\~", "<", "\\<",
+)
+
+// escapeMarkdownText escapes the characters that start inline markup
+func escapeMarkdownText(s string) string {
+	s = markdownTextEscaper.Replace(s)
+	if !strings.Contains(s, "&") {
+		return s
+	}
+
+	// Only ampersands that would read as an entity need escaping
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '&' && mdEntityPattern.MatchString(s[i:]) {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+var markdownBlockStart = regexp.MustCompile(`^(?:[#>+=-]|\d{1,9}[.)])`)
+
+// escapeMarkdownLineStart keeps a line of text from reading as a header,
+// quote, list item or setext underline
+func escapeMarkdownLineStart(s string) string {
+	m := markdownBlockStart.FindString(s)
+	if m == "" {
+		return s
+	}
+	return m[:len(m)-1] + "\\" + m[len(m)-1:] + s[len(m):]
+}
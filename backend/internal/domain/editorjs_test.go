@@ -0,0 +1,65 @@
+package domain
+
+import "testing"
+
+func TestSanitizeInlineHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text", "hello", "hello"},
+		{"entities stay escaped", "a &amp; b &lt;c&gt;", "a &amp; b &lt;c&gt;"},
+		{"bare ampersand is escaped", "a & b", "a &amp; b"},
+		{"allowed tags", "<b>b</b><i>i</i><code>c</code><mark>m</mark>", "<b>b</b><i>i</i><code>c</code><mark>m</mark>"},
+		{"attributes are dropped", `<b class="x" onclick="alert(1)">b</b>`, "<b>b</b>"},
+		{"disallowed tags are dropped", `<span style="color:red">red</span><div>block</div>`, "redblock"},
+		{"script content is escaped text", "<script>alert(1)</script>", "alert(1)"},
+		{"img is dropped", `<img src=x onerror="alert(1)">`, ""},
+		{"br is normalized", "a<br/>b<br >c", "a<br>b<br>c"},
+		{"protocol-relative link loses its href", `<a href="//evil.example">x</a>`, "<a>x</a>"},
+		{"safe link", `<a href="https://example.com/?a=1&amp;b=2" target="_blank">x</a>`, `<a href="https://example.com/?a=1&amp;b=2" rel="noopener noreferrer">x</a>`},
+		{"javascript link loses its href", `<a href="javascript:alert(1)">x</a>`, "<a>x</a>"},
+		{"unclosed tags are closed", "<b><i>x", "<b><i>x</i></b>"},
+		{"misnested tags are closed in order", "<b><i>x</b>y</i>", "<b><i>x</i></b>y"},
+		{"stray closing tags are dropped", "x</b></a>", "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeInlineHTML(tt.in); got != tt.want {
+				t.Errorf("SanitizeInlineHTML(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSafeURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://example.com", true},
+		{"HTTP://EXAMPLE.COM", true},
+		{"  https://example.com  ", true},
+		{"mailto:me@example.com", true},
+		{"/articles/go", true},
+		{"#section", true},
+		{"", false},
+		{"javascript:alert(1)", false},
+		{" JavaScript:alert(1)", false},
+		{"data:text/html;base64,PHNjcmlwdD4=", false},
+		{"vbscript:msgbox", false},
+		{"example.com", false},
+		{"//evil.example", false},
+		{" //evil.example", false},
+		{"/\\evil.example", false},
+		{`\\evil.example`, false},
+	}
+
+	for _, tt := range tests {
+		if got := isSafeURL(tt.url); got != tt.want {
+			t.Errorf("isSafeURL(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ViewDedupWindow is how long repeat views from one visitor count as a single view
+const ViewDedupWindow = 30 * time.Minute
+
+// MaxVisitorsPerNetwork is how many anonymous visitors one IP address and
+// user agent count as per article: enough for people sharing an address,
+// too few for a client rotating X-Visitor-ID to inflate views or likes
+const MaxVisitorsPerNetwork = 5
+
+// Visitor identifies who viewed or liked an article: a signed-in customer,
+// or an anonymous visitor by a salted hash of request details
+type Visitor struct {
+	UserID      string
+	Fingerprint string
+	Network     string // salted hash of IP and user agent, shared by every visitor at that address
+	UserAgent   string
+	Referrer    string // page the visitor came from, if known
+}
+
+// Key is the stable identity used to deduplicate views and likes
+func (v Visitor) Key() string {
+	if v.UserID != "" {
+		return "user:" + v.UserID
+	}
+	return "anon:" + v.Fingerprint
+}
+
+// IsKnown reports whether the visitor can be told apart from others
+func (v Visitor) IsKnown() bool {
+	return v.UserID != "" || v.Fingerprint != ""
+}
+
+// NetworkKey is the address an anonymous visitor is capped under, or ""
+// for signed-in customers, who are identified by their account
+func (v Visitor) NetworkKey() string {
+	if v.UserID != "" {
+		return ""
+	}
+	return v.Network
+}
+
+var (
+	ErrUnknownVisitor  = errors.New("visitor could not be identified")
+	ErrTooManyVisitors = errors.New("too many visitors from this address")
+)
+
+// LikeState is the like status of an article for one visitor
+type LikeState struct {
+	Liked bool `json:"liked"`
+	Likes int  `json:"likes"`
+}
+
+// LikeRollup returns the daily stats bucket a like toggle changes and by
+// how much. A like counts on today; an unlike takes the like back from the
+// day it was made, so earlier days stay net of unlikes and today is not
+// pushed below zero.
+func (s LikeState) LikeRollup(likedOn, today time.Time) (day time.Time, delta int) {
+	if s.Liked {
+		return today, 1
+	}
+	return likedOn, -1
+}
+
+var botUserAgentMarkers = []string{
+	"bot", "crawler", "spider", "slurp", "curl", "wget", "python-requests",
+	"headless", "lighthouse", "preview", "facebookexternalhit", "go-http-client",
+}
+
+// IsBotUserAgent reports whether a user agent looks automated; such
+// requests are not counted as views
+func IsBotUserAgent(userAgent string) bool {
+	if strings.TrimSpace(userAgent) == "" {
+		return true
+	}
+
+	ua := strings.ToLower(userAgent)
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
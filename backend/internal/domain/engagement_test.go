@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLikeStateLikeRollup(t *testing.T) {
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	lastWeek := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		state     LikeState
+		likedOn   time.Time
+		wantDay   time.Time
+		wantDelta int
+	}{
+		{"like counts today", LikeState{Liked: true}, time.Time{}, today, 1},
+		{"unlike on the day of the like", LikeState{Liked: false}, today, today, -1},
+		{"unlike on a later day takes the like back from its own day", LikeState{Liked: false}, lastWeek, lastWeek, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, delta := tt.state.LikeRollup(tt.likedOn, today)
+			if !day.Equal(tt.wantDay) || delta != tt.wantDelta {
+				t.Errorf("LikeRollup() = %s, %d, want %s, %d",
+					day.Format("2006-01-02"), delta, tt.wantDay.Format("2006-01-02"), tt.wantDelta)
+			}
+		})
+	}
+}
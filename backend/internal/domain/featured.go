@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/project"
+)
+
+// Named places on the site that show hand-picked content
+const (
+	FeaturedSlotHero         = "hero"
+	FeaturedSlotSidebar      = "sidebar"
+	FeaturedSlotHomepageGrid = "homepage_grid"
+)
+
+// FeaturedSlots lists the slots in the order they are returned
+var FeaturedSlots = []string{FeaturedSlotHero, FeaturedSlotSidebar, FeaturedSlotHomepageGrid}
+
+// FeaturedSlotCapacity is how many entries of a slot are shown at once. A
+// slot may hold more, for example heroes queued with later start dates.
+var FeaturedSlotCapacity = map[string]int{
+	FeaturedSlotHero:         1,
+	FeaturedSlotSidebar:      5,
+	FeaturedSlotHomepageGrid: 6,
+}
+
+// Content types that can be placed in a slot
+const (
+	FeaturedContentArticle = "article"
+	FeaturedContentProject = "project"
+	FeaturedContentCourse  = "course"
+)
+
+var (
+	ErrInvalidFeaturedSlot        = errors.New("slot must be hero, sidebar or homepage_grid")
+	ErrInvalidFeaturedContentType = errors.New("content type must be article, project or course")
+	ErrInvalidFeaturedWindow      = errors.New("endsAt must be after startsAt")
+	ErrInvalidFeaturedPosition    = errors.New("position must not be negative")
+	ErrInvalidFeaturedOrder       = errors.New("ids must list every entry of the slot exactly once")
+	ErrFeaturedItemNotFound       = errors.New("featured entry not found")
+	ErrFeaturedItemExists         = errors.New("content is already in this slot")
+)
+
+// FeaturedItem places one article, project or course in a slot. Entries are
+// shown by ascending Position, and only between StartsAt and EndsAt when set.
+type FeaturedItem struct {
+	ID          string     `json:"id" db:"id"`
+	Slot        string     `json:"slot" db:"slot"`
+	ContentType string     `json:"contentType" db:"content_type"`
+	ContentID   string     `json:"contentId" db:"content_id"`
+	Position    int        `json:"position" db:"position"`
+	StartsAt    *time.Time `json:"startsAt,omitempty" db:"starts_at"`
+	EndsAt      *time.Time `json:"endsAt,omitempty" db:"ends_at"`
+	CreatedBy   string     `json:"createdBy" db:"created_by"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// Active reports whether the entry's date window includes now
+func (f *FeaturedItem) Active(now time.Time) bool {
+	return (f.StartsAt == nil || !f.StartsAt.After(now)) && (f.EndsAt == nil || f.EndsAt.After(now))
+}
+
+type CreateFeaturedItemRequest struct {
+	ContentType string     `json:"contentType" binding:"required"`
+	ContentID   string     `json:"contentId" binding:"required"`
+	Position    *int       `json:"position"` // appended to the slot when omitted
+	StartsAt    *time.Time `json:"startsAt"`
+	EndsAt      *time.Time `json:"endsAt"`
+}
+
+// UpdateFeaturedItemRequest replaces an entry's date window; an omitted
+// date removes that bound. An omitted position keeps the current one.
+type UpdateFeaturedItemRequest struct {
+	Position *int       `json:"position"`
+	StartsAt *time.Time `json:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt"`
+}
+
+type ReorderFeaturedRequest struct {
+	IDs []string `json:"ids" binding:"required"`
+}
+
+// FeaturedEntry is a slot entry with the content it points at; exactly one
+// of Article, Project and Course is set
+type FeaturedEntry struct {
+	Item    *FeaturedItem
+	Article *Article
+	Project *project.Project
+	Course  *course.Course
+}
+
+type FeaturedRepository interface {
+	// GetBySlot returns every entry of a slot in display order
+	GetBySlot(ctx context.Context, slot string) ([]*FeaturedItem, error)
+	// GetActive returns the entries of all slots whose window includes now,
+	// in display order within each slot
+	GetActive(ctx context.Context, now time.Time) ([]*FeaturedItem, error)
+	GetByID(ctx context.Context, id string) (*FeaturedItem, error)
+	// Create stores the entry, appending it to the slot when Position is negative
+	Create(ctx context.Context, item *FeaturedItem) error
+	Update(ctx context.Context, item *FeaturedItem) error
+	Delete(ctx context.Context, id string) error
+	// Reorder sets the positions of a slot's entries to the order of ids
+	Reorder(ctx context.Context, slot string, ids []string) error
+}
+
+type FeaturedUsecase interface {
+	// GetSlots returns every entry of every slot, scheduled and expired
+	// ones included, keyed by slot
+	GetSlots(ctx context.Context) (map[string][]*FeaturedItem, error)
+	GetSlot(ctx context.Context, slot string) ([]*FeaturedItem, error)
+	AddItem(ctx context.Context, slot string, req CreateFeaturedItemRequest, createdBy string) (*FeaturedItem, error)
+	UpdateItem(ctx context.Context, slot, id string, req UpdateFeaturedItemRequest) (*FeaturedItem, error)
+	RemoveItem(ctx context.Context, slot, id string) error
+	ReorderSlot(ctx context.Context, slot string, ids []string) ([]*FeaturedItem, error)
+
+	// ResolveSlot returns what a slot shows now: its active entries whose
+	// content is public, up to the slot's capacity. Articles are shown in
+	// lang when they have a published translation.
+	ResolveSlot(ctx context.Context, slot, lang string) ([]*FeaturedEntry, error)
+	// ResolveSlots resolves every slot, keyed by slot
+	ResolveSlots(ctx context.Context, lang string) (map[string][]*FeaturedEntry, error)
+}
+
+// IsValidFeaturedSlot reports whether slot is one of FeaturedSlots
+func IsValidFeaturedSlot(slot string) bool {
+	_, ok := FeaturedSlotCapacity[slot]
+	return ok
+}
+
+// IsValidFeaturedContentType reports whether a content type can be placed in a slot
+func IsValidFeaturedContentType(contentType string) bool {
+	switch contentType {
+	case FeaturedContentArticle, FeaturedContentProject, FeaturedContentCourse:
+		return true
+	}
+	return false
+}
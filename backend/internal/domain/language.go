@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Article languages, matching the codes served by the locale subsystem
+const (
+	LanguageEnglish    = "en"
+	LanguageIndonesian = "id"
+)
+
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// searchConfigs maps article languages to PostgreSQL text search configurations
+var searchConfigs = map[string]string{
+	LanguageEnglish:    "english",
+	LanguageIndonesian: "indonesian",
+}
+
+// Frequent function words used to tell English and Indonesian apart
+var (
+	englishStopWords = map[string]bool{
+		"the": true, "and": true, "of": true, "to": true, "is": true, "in": true,
+		"that": true, "for": true, "with": true, "this": true, "it": true, "on": true,
+		"are": true, "be": true, "as": true, "was": true, "you": true, "how": true,
+	}
+	indonesianStopWords = map[string]bool{
+		"yang": true, "dan": true, "di": true, "ini": true, "itu": true, "dengan": true,
+		"untuk": true, "dari": true, "dalam": true, "tidak": true, "akan": true, "pada": true,
+		"adalah": true, "juga": true, "ke": true, "bisa": true, "cara": true, "kita": true,
+	}
+)
+
+// IsSupportedLanguage reports whether articles can be written and searched in lang
+func IsSupportedLanguage(lang string) bool {
+	_, ok := searchConfigs[lang]
+	return ok
+}
+
+// PreferredLanguage returns the supported article language ranked highest
+// in an Accept-Language header, or "" when none is acceptable. Region
+// subtags are ignored, so "id-ID" counts as "id".
+func PreferredLanguage(acceptLanguage string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := strings.ToLower(strings.TrimSpace(fields[0]))
+		if i := strings.IndexByte(lang, '-'); i > 0 {
+			lang = lang[:i]
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		if q > 0 && IsSupportedLanguage(lang) {
+			candidates = append(candidates, candidate{lang, q})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0].lang
+}
+
+// SearchConfig returns the text search configuration for lang, defaulting to English
+func SearchConfig(lang string) string {
+	if config, ok := searchConfigs[lang]; ok {
+		return config
+	}
+	return searchConfigs[LanguageEnglish]
+}
+
+// DetectLanguage guesses whether text is English or Indonesian by counting
+// common function words. Ties, including text with none, fall back to English.
+func DetectLanguage(text string) string {
+	english, indonesian := 0, 0
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		if englishStopWords[word] {
+			english++
+		}
+		if indonesianStopWords[word] {
+			indonesian++
+		}
+	}
+
+	if indonesian > english {
+		return LanguageIndonesian
+	}
+	return LanguageEnglish
+}
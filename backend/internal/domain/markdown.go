@@ -0,0 +1,572 @@
+package domain
+
+import (
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// EditorJSVersion is the editor version stamped on documents built outside
+// the admin editor, e.g. by the Markdown importer
+const EditorJSVersion = "2.31.1"
+
+var (
+	mdFencePattern         = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})\\s*([^`\\s]*)")
+	mdHeaderPattern        = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	mdSetextPattern        = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	mdThematicPattern      = regexp.MustCompile(`^ {0,3}([-*_])(?:[ \t]*([-*_])){2,}[ \t]*$`)
+	mdListPattern          = regexp.MustCompile(`^([ \t]*)([-*+]|\d{1,9}[.)])(?:[ \t]+(.*))?$`)
+	mdTaskPattern          = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	mdQuotePattern         = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+	mdImagePattern         = regexp.MustCompile(`^!\[((?:\\.|[^\]\\])*)\]\(\s*(<[^>]*>|[^)\s]+)(?:\s+"[^"]*")?\s*\)$`)
+	mdEntityPattern        = regexp.MustCompile(`^&(?:[A-Za-z][A-Za-z0-9]*|#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6});`)
+	mdInlineTagPattern     = regexp.MustCompile(`^<(?:/?(?:b|strong|i|em|u|s|code|mark|sub|sup|a)|a\s+[^<>]*|br\s*/?)>`)
+	mdEscapablePunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+)
+
+// ParseMarkdown converts a Markdown document to EditorJS blocks: ATX and
+// setext headers, paragraphs, fenced code with its language, nested bullet,
+// ordered and task lists, and images standing alone in a paragraph. Inline
+// emphasis, code, strike-through and links become the inline HTML EditorJS
+// stores. Block quotes are kept as plain paragraphs; thematic breaks and
+// other constructs EditorJS cannot hold are dropped.
+func ParseMarkdown(markdown string) *EditorJSDocument {
+	p := &markdownParser{
+		lines: strings.Split(strings.ReplaceAll(strings.ReplaceAll(markdown, "\r\n", "\n"), "\r", "\n"), "\n"),
+	}
+	p.parse()
+
+	return &EditorJSDocument{
+		Time:    time.Now().UnixMilli(),
+		Blocks:  p.blocks,
+		Version: EditorJSVersion,
+	}
+}
+
+type markdownParser struct {
+	lines     []string
+	pos       int
+	paragraph []string
+	blocks    []EditorJSBlock
+}
+
+func (p *markdownParser) parse() {
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			p.flushParagraph()
+			p.pos++
+		case mdFencePattern.MatchString(line):
+			p.flushParagraph()
+			p.parseFence()
+		case mdHeaderPattern.MatchString(line):
+			p.flushParagraph()
+			m := mdHeaderPattern.FindStringSubmatch(line)
+			p.addBlock("header", map[string]interface{}{
+				"text":  parseInlineMarkdown(m[2]),
+				"level": len(m[1]),
+			})
+			p.pos++
+		case len(p.paragraph) > 0 && mdSetextPattern.MatchString(line):
+			level := 2
+			if strings.TrimSpace(line)[0] == '=' {
+				level = 1
+			}
+			text := joinMarkdownLines(p.paragraph)
+			p.paragraph = nil
+			p.addBlock("header", map[string]interface{}{
+				"text":  parseInlineMarkdown(text),
+				"level": level,
+			})
+			p.pos++
+		case isThematicBreak(line):
+			p.flushParagraph()
+			p.pos++
+		case mdListPattern.MatchString(line):
+			p.flushParagraph()
+			p.parseList()
+		case mdQuotePattern.MatchString(line):
+			p.paragraph = append(p.paragraph, mdQuotePattern.FindStringSubmatch(line)[1])
+			p.pos++
+		default:
+			p.paragraph = append(p.paragraph, line)
+			p.pos++
+		}
+	}
+	p.flushParagraph()
+}
+
+func (p *markdownParser) addBlock(blockType string, data interface{}) {
+	raw, _ := json.Marshal(data)
+	p.blocks = append(p.blocks, EditorJSBlock{Type: blockType, Data: raw})
+}
+
+// flushParagraph turns the pending paragraph lines into a paragraph block,
+// or an image block when the paragraph is a single image
+func (p *markdownParser) flushParagraph() {
+	if len(p.paragraph) == 0 {
+		return
+	}
+	text := joinMarkdownLines(p.paragraph)
+	p.paragraph = nil
+
+	if m := mdImagePattern.FindStringSubmatch(strings.TrimSpace(text)); m != nil {
+		src := strings.TrimSuffix(strings.TrimPrefix(m[2], "<"), ">")
+		p.addBlock("image", map[string]string{
+			"url":     unescapeMarkdown(src),
+			"caption": unescapeMarkdown(m[1]),
+		})
+		return
+	}
+
+	p.addBlock("paragraph", map[string]string{"text": parseInlineMarkdown(text)})
+}
+
+func (p *markdownParser) parseFence() {
+	m := mdFencePattern.FindStringSubmatch(p.lines[p.pos])
+	indent, fence, language := len(m[1]), m[2], m[3]
+	p.pos++
+
+	var code []string
+	for ; p.pos < len(p.lines); p.pos++ {
+		line := p.lines[p.pos]
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			p.pos++
+			break
+		}
+		// Strip up to the fence's own indentation from the content
+		for i := 0; i < indent && strings.HasPrefix(line, " "); i++ {
+			line = line[1:]
+		}
+		code = append(code, line)
+	}
+
+	data := map[string]string{"code": strings.Join(code, "\n")}
+	if language != "" {
+		data["language"] = unescapeMarkdown(language)
+	}
+	p.addBlock("code", data)
+}
+
+// markdownListItem is a list item while its nesting is being worked out
+type markdownListItem struct {
+	indent  int
+	lines   []string
+	checked *bool
+	items   []*markdownListItem
+}
+
+func (p *markdownParser) parseList() {
+	first := mdListPattern.FindStringSubmatch(p.lines[p.pos])
+	style := "unordered"
+	if isOrderedMarker(first[2]) {
+		style = "ordered"
+	} else if mdTaskPattern.MatchString(first[3]) {
+		style = "checklist"
+	}
+
+	rootIndent, delimiter := indentWidth(first[1]), listDelimiter(first[2])
+	var roots []*markdownListItem
+	var stack []*markdownListItem
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+
+		if strings.TrimSpace(line) == "" {
+			// A blank line ends the list unless another item follows
+			next := p.pos + 1
+			for next < len(p.lines) && strings.TrimSpace(p.lines[next]) == "" {
+				next++
+			}
+			if next >= len(p.lines) || !mdListPattern.MatchString(p.lines[next]) || isThematicBreak(p.lines[next]) {
+				break
+			}
+			p.pos = next
+			continue
+		}
+
+		m := mdListPattern.FindStringSubmatch(line)
+		if m == nil || isThematicBreak(line) {
+			// Other lines continue the current item unless they start a new block
+			if len(stack) == 0 || startsMarkdownBlock(line) {
+				break
+			}
+			current := stack[len(stack)-1]
+			current.lines = append(current.lines, strings.TrimSpace(line))
+			p.pos++
+			continue
+		}
+
+		item := &markdownListItem{indent: indentWidth(m[1])}
+		// A top-level item with another bullet or delimiter starts a new list
+		if item.indent <= rootIndent && listDelimiter(m[2]) != delimiter {
+			break
+		}
+		content := m[3]
+		if style == "checklist" {
+			if task := mdTaskPattern.FindStringSubmatch(content); task != nil {
+				checked := task[1] != " "
+				item.checked = &checked
+				content = content[len(task[0]):]
+			}
+		}
+		item.lines = []string{content}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= item.indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, item)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.items = append(parent.items, item)
+		}
+		stack = append(stack, item)
+		p.pos++
+	}
+
+	p.addBlock("list", map[string]interface{}{
+		"style": style,
+		"meta":  map[string]interface{}{},
+		"items": buildListItemData(roots, style),
+	})
+}
+
+func buildListItemData(items []*markdownListItem, style string) []map[string]interface{} {
+	data := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		meta := map[string]interface{}{}
+		if style == "checklist" {
+			meta["checked"] = item.checked != nil && *item.checked
+		}
+		data = append(data, map[string]interface{}{
+			"content": parseInlineMarkdown(joinMarkdownLines(item.lines)),
+			"meta":    meta,
+			"items":   buildListItemData(item.items, style),
+		})
+	}
+	return data
+}
+
+func startsMarkdownBlock(line string) bool {
+	return mdFencePattern.MatchString(line) || mdHeaderPattern.MatchString(line) ||
+		mdQuotePattern.MatchString(line) || isThematicBreak(line)
+}
+
+// listDelimiter is the bullet character, or the character after the number
+// of an ordered marker
+func listDelimiter(marker string) byte {
+	return marker[len(marker)-1]
+}
+
+func isOrderedMarker(marker string) bool {
+	return marker[0] >= '0' && marker[0] <= '9'
+}
+
+func isThematicBreak(line string) bool {
+	m := mdThematicPattern.FindStringSubmatch(line)
+	if m == nil {
+		return false
+	}
+	// Every marker must be the same character
+	return strings.Trim(strings.TrimSpace(line), m[1]+" \t") == ""
+}
+
+// indentWidth counts leading whitespace, with tabs as four columns
+func indentWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch r {
+		case ' ':
+			width++
+		case '\t':
+			width += 4
+		default:
+			return width
+		}
+	}
+	return width
+}
+
+// joinMarkdownLines joins the lines of a paragraph. A line ending in two
+// spaces or a backslash is a hard break, kept as a newline for the inline
+// parser; other line ends are soft and become spaces.
+func joinMarkdownLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " \t")
+		last := i == len(lines)-1
+		switch {
+		case !last && strings.HasSuffix(line, "  "):
+			b.WriteString(strings.TrimRight(line, " "))
+			b.WriteString("\n")
+		case !last && strings.HasSuffix(line, "\\") && !strings.HasSuffix(line, "\\\\"):
+			b.WriteString(strings.TrimSuffix(line, "\\"))
+			b.WriteString("\n")
+		default:
+			b.WriteString(strings.TrimRight(line, " \t"))
+			if !last {
+				b.WriteString(" ")
+			}
+		}
+	}
+	return b.String()
+}
+
+// unescapeMarkdown removes backslash escapes from plain text
+func unescapeMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(mdEscapablePunctuation, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// parseInlineMarkdown converts inline Markdown to the inline HTML EditorJS
+// stores. Text is HTML-escaped; the inline tags EditorJS itself produces
+// may be written as raw HTML and are passed through.
+func parseInlineMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(mdEscapablePunctuation, s[i+1]) >= 0:
+			b.WriteString(escapeInlineText(s[i+1 : i+2]))
+			i += 2
+			continue
+		case c == '\n':
+			b.WriteString("<br>")
+			i++
+			continue
+		case c == '`':
+			if code, next, ok := parseCodeSpan(s, i); ok {
+				b.WriteString("<code>" + escapeInlineText(code) + "</code>")
+				i = next
+				continue
+			}
+			// An unmatched run of backticks is literal
+			run := countRun(s, i, '`')
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		case c == '*' || c == '_' || c == '~':
+			if out, next, ok := parseEmphasis(s, i); ok {
+				b.WriteString(out)
+				i = next
+				continue
+			}
+			run := countRun(s, i, c)
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		case c == '[':
+			if out, next, ok := parseLink(s, i); ok {
+				b.WriteString(out)
+				i = next
+				continue
+			}
+		case c == '<':
+			if tag := mdInlineTagPattern.FindString(s[i:]); tag != "" {
+				b.WriteString(tag)
+				i += len(tag)
+				continue
+			}
+		case c == '&':
+			if entity := mdEntityPattern.FindString(s[i:]); entity != "" {
+				b.WriteString(entity)
+				i += len(entity)
+				continue
+			}
+		}
+		b.WriteString(escapeInlineText(s[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+var inlineTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// escapeInlineText escapes text the way the editor does, leaving quotes as is
+func escapeInlineText(s string) string {
+	return inlineTextEscaper.Replace(s)
+}
+
+func countRun(s string, i int, c byte) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == c {
+		n++
+	}
+	return n
+}
+
+// parseCodeSpan matches a code span opening at i, closed by a backtick run
+// of the same length
+func parseCodeSpan(s string, i int) (string, int, bool) {
+	run := countRun(s, i, '`')
+	for j := i + run; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		closing := countRun(s, j, '`')
+		if closing == run {
+			code := strings.ReplaceAll(s[i+run:j], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+				code = code[1 : len(code)-1]
+			}
+			return code, j + closing, true
+		}
+		j += closing
+	}
+	return "", 0, false
+}
+
+// parseEmphasis matches **strong**, __strong__, *em*, _em_ or ~~strike~~
+// opening at i. Underscores do not open or close emphasis inside a word.
+func parseEmphasis(s string, i int) (string, int, bool) {
+	c := s[i]
+	run := countRun(s, i, c)
+
+	var delim, tag string
+	switch {
+	case c == '~' && run == 2:
+		delim, tag = "~~", "s"
+	case c == '~':
+		return "", 0, false
+	case run >= 2:
+		delim, tag = s[i:i+2], "b"
+	default:
+		delim, tag = s[i:i+1], "i"
+	}
+
+	start := i + len(delim)
+	if start >= len(s) || s[start] == ' ' || s[start] == '\n' {
+		return "", 0, false
+	}
+	if c == '_' && i > 0 && isWordByte(s[i-1]) {
+		return "", 0, false
+	}
+
+	end := findClosingDelimiter(s, start, delim)
+	if end < 0 {
+		return "", 0, false
+	}
+	inner := parseInlineMarkdown(s[start:end])
+	return "<" + tag + ">" + inner + "</" + tag + ">", end + len(delim), true
+}
+
+// findClosingDelimiter finds delim closing an emphasis span, skipping
+// escapes, code spans and links so their contents cannot close it
+func findClosingDelimiter(s string, from int, delim string) int {
+	c := delim[0]
+	for j := from; j < len(s); {
+		switch s[j] {
+		case '\\':
+			j += 2
+			continue
+		case '`':
+			if _, next, ok := parseCodeSpan(s, j); ok {
+				j = next
+				continue
+			}
+			j += countRun(s, j, '`')
+			continue
+		case '[':
+			if _, next, ok := parseLink(s, j); ok {
+				j = next
+				continue
+			}
+		}
+
+		if s[j] != c {
+			j++
+			continue
+		}
+		run := countRun(s, j, c)
+		// A single delimiter must not close on part of a double one
+		// opening a nested span, e.g. *a **b** c*
+		if len(delim) == 1 && run == 2 {
+			j += run
+			continue
+		}
+		if run >= len(delim) && s[j-1] != ' ' {
+			closeAt := j + run - len(delim)
+			after := closeAt + len(delim)
+			if c != '_' || after >= len(s) || !isWordByte(s[after]) {
+				return closeAt
+			}
+		}
+		j += run
+	}
+	return -1
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 0x80 || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parseLink matches [text](url) opening at i
+func parseLink(s string, i int) (string, int, bool) {
+	depth := 0
+	end := -1
+	for j := i; j < len(s) && end < 0; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '`':
+			if _, next, ok := parseCodeSpan(s, j); ok {
+				j = next - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = j
+			}
+		}
+	}
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return "", 0, false
+	}
+
+	rest := s[end+2:]
+	var href string
+	var consumed int
+	if strings.HasPrefix(rest, "<") {
+		closeAt := strings.IndexByte(rest, '>')
+		if closeAt < 0 {
+			return "", 0, false
+		}
+		href = rest[1:closeAt]
+		consumed = closeAt + 1
+	} else {
+		consumed = strings.IndexAny(rest, " )")
+		if consumed < 0 {
+			return "", 0, false
+		}
+		href = rest[:consumed]
+	}
+
+	tail := rest[consumed:]
+	closeAt := strings.IndexByte(tail, ')')
+	if closeAt < 0 || !isLinkTitle(strings.TrimSpace(tail[:closeAt])) {
+		return "", 0, false
+	}
+
+	text := parseInlineMarkdown(s[i+1 : end])
+	out := `<a href="` + html.EscapeString(unescapeMarkdown(href)) + `">` + text + "</a>"
+	return out, end + 2 + consumed + closeAt + 1, true
+}
+
+// isLinkTitle accepts the optional "title" after a link destination
+func isLinkTitle(s string) bool {
+	return s == "" || (len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"')
+}
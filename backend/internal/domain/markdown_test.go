@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// roundTripBlock is the part of a block that must survive Markdown export
+// and import; ids and list meta the importer fills in are left out
+type roundTripBlock struct {
+	Type     string
+	Text     string
+	Level    int
+	Style    string
+	Items    []editorJSListItem
+	Code     string
+	Language string
+	Caption  string
+	URL      string
+}
+
+func roundTripBlocks(blocks []EditorJSBlock) []roundTripBlock {
+	out := make([]roundTripBlock, 0, len(blocks))
+	for _, block := range blocks {
+		data := block.data()
+		b := roundTripBlock{Type: block.Type}
+		switch block.Type {
+		case "header":
+			b.Text, b.Level = data.Text, data.Level
+		case "paragraph":
+			b.Text = data.Text
+		case "list":
+			b.Style, b.Items = data.Style, normalizeListItems(data.listItems())
+		case "code":
+			b.Code, b.Language = data.Code, data.Language
+		case "image":
+			b.Caption, b.URL = data.Caption, data.imageURL()
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// normalizeListItems treats flat string items and nested items without
+// children alike
+func normalizeListItems(items []editorJSListItem) []editorJSListItem {
+	if len(items) == 0 {
+		return nil
+	}
+	for i := range items {
+		items[i].Items = normalizeListItems(items[i].Items)
+	}
+	return items
+}
+
+func block(t *testing.T, blockType string, data interface{}) EditorJSBlock {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("marshal %s block: %v", blockType, err)
+	}
+	return EditorJSBlock{Type: blockType, Data: raw}
+}
+
+func listItem(content string, items ...map[string]interface{}) map[string]interface{} {
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+	return map[string]interface{}{"content": content, "meta": map[string]interface{}{}, "items": items}
+}
+
+func checklistItem(content string, checked bool) map[string]interface{} {
+	return map[string]interface{}{
+		"content": content,
+		"meta":    map[string]interface{}{"checked": checked},
+		"items":   []map[string]interface{}{},
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		blocks func(t *testing.T) []EditorJSBlock
+	}{
+		{
+			name: "nested unordered list",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "list", map[string]interface{}{
+					"style": "unordered",
+					"items": []map[string]interface{}{
+						listItem("one", listItem("one.a", listItem("one.a.i")), listItem("one.b")),
+						listItem("two"),
+					},
+				})}
+			},
+		},
+		{
+			name: "nested ordered list past nine items",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				items := make([]map[string]interface{}, 0, 10)
+				for _, s := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+					items = append(items, listItem(s))
+				}
+				items = append(items, listItem("j", listItem("j.1"), listItem("j.2")))
+				return []EditorJSBlock{block(t, "list", map[string]interface{}{"style": "ordered", "items": items})}
+			},
+		},
+		{
+			name: "checklist",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "list", map[string]interface{}{
+					"style": "checklist",
+					"items": []map[string]interface{}{checklistItem("done", true), checklistItem("todo", false)},
+				})}
+			},
+		},
+		{
+			name: "adjacent lists",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{
+					block(t, "list", map[string]interface{}{"style": "unordered", "items": []string{"a", "b"}}),
+					block(t, "list", map[string]interface{}{"style": "unordered", "items": []string{"c"}}),
+					block(t, "list", map[string]interface{}{"style": "unordered", "items": []string{"d"}}),
+					block(t, "list", map[string]interface{}{"style": "ordered", "items": []string{"e"}}),
+					block(t, "list", map[string]interface{}{"style": "ordered", "items": []string{"f"}}),
+				}
+			},
+		},
+		{
+			name: "fenced code containing backticks",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "code", map[string]string{
+					"code":     "fmt.Println(`raw`)\n```\nnot a fence\n````",
+					"language": "go",
+				})}
+			},
+		},
+		{
+			name: "fenced code without a language",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "code", map[string]string{"code": "~~~\n  indented"})}
+			},
+		},
+		{
+			name: "image url with spaces",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "image", map[string]interface{}{
+					"file":    map[string]string{"url": "/uploads/my photo.png"},
+					"caption": "A photo",
+				})}
+			},
+		},
+		{
+			name: "image url with parentheses",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{block(t, "image", map[string]interface{}{
+					"file":    map[string]string{"url": "https://example.com/Go_(language).png"},
+					"caption": "Go [logo] *v2*",
+				})}
+			},
+		},
+		{
+			name: "escaped hash and asterisk",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{
+					block(t, "header", map[string]interface{}{"text": "C# tips #", "level": 2}),
+					block(t, "paragraph", map[string]string{"text": "# not a header"}),
+					block(t, "paragraph", map[string]string{"text": "*not emphasis* and 2 * 3"}),
+					block(t, "paragraph", map[string]string{"text": "**not strong**"}),
+					block(t, "list", map[string]interface{}{"style": "unordered", "items": []string{"# item", "* item"}}),
+				}
+			},
+		},
+		{
+			name: "text that reads as block markup",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{
+					block(t, "paragraph", map[string]string{"text": "1. not a list"}),
+					block(t, "paragraph", map[string]string{"text": "- not a bullet"}),
+					block(t, "paragraph", map[string]string{"text": "&gt; not a quote"}),
+					block(t, "paragraph", map[string]string{"text": "="}),
+				}
+			},
+		},
+		{
+			name: "inline formatting",
+			blocks: func(t *testing.T) []EditorJSBlock {
+				return []EditorJSBlock{
+					block(t, "header", map[string]interface{}{"text": "Using <code>go test</code>", "level": 3}),
+					block(t, "paragraph", map[string]string{
+						"text": `<b>bold</b>, <i>italic</i>, <s>gone</s>, <code>a` + "`" + `b</code> and <a href="https://example.com/a_(b)">a link</a>`,
+					}),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &EditorJSDocument{Blocks: tt.blocks(t)}
+			markdown := doc.Markdown()
+
+			got := roundTripBlocks(ParseMarkdown(markdown).Blocks)
+			want := roundTripBlocks(doc.Blocks)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip changed the document\nmarkdown:\n%s\ngot:  %+v\nwant: %+v", markdown, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of the last item on a keyset-paginated page: its
+// sort key and ID, the tie-breaker. The next page starts right after it, so
+// items published while a reader scrolls never shift the pages already seen.
+// The zero Cursor starts at the top of the list.
+type Cursor struct {
+	Time  time.Time `json:"t,omitempty"`
+	Order int64     `json:"o,omitempty"` // numeric sort key, e.g. display order
+	ID    string    `json:"id,omitempty"`
+	Sort  string    `json:"s,omitempty"` // the list order the cursor belongs to
+}
+
+// IsStart reports whether the cursor points at the top of the list
+func (c Cursor) IsStart() bool {
+	return c.ID == ""
+}
+
+// Encode returns the cursor as an opaque URL-safe string
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode parses a cursor from Encode. An empty string is the start of the list.
+func Decode(s string) (*Cursor, error) {
+	cursor := &Cursor{}
+	if s == "" {
+		return cursor, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	return cursor, nil
+}
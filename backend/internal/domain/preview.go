@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"portfolio/internal/domain/course"
+)
+
+// Content types that can be previewed before they are published
+const (
+	PreviewContentArticle = "article"
+	PreviewContentCourse  = "course"
+)
+
+// Lifetime of a preview link, in hours
+const (
+	DefaultPreviewTTLHours = 7 * 24
+	MaxPreviewTTLHours     = 30 * 24
+)
+
+var (
+	ErrPreviewTokenNotFound      = errors.New("preview link not found or revoked")
+	ErrPreviewTokenExpired       = errors.New("preview link has expired")
+	ErrInvalidPreviewContentType = errors.New("content type must be article or course")
+	ErrInvalidPreviewTTL         = errors.New("expiresInHours must be between 1 and 720")
+)
+
+// PreviewToken grants read access to one unpublished article or course.
+// Token is only set on the response that creates it.
+type PreviewToken struct {
+	ID          string     `json:"id" db:"id"`
+	ContentType string     `json:"contentType" db:"content_type"`
+	ContentID   string     `json:"contentId" db:"content_id"`
+	Token       string     `json:"token,omitempty" db:"-"`
+	TokenHash   string     `json:"-" db:"token_hash"`
+	CreatedBy   string     `json:"createdBy" db:"created_by"`
+	ExpiresAt   time.Time  `json:"expiresAt" db:"expires_at"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty" db:"revoked_at"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// Active reports whether the token can still be used at now
+func (t *PreviewToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+type CreatePreviewTokenRequest struct {
+	ExpiresInHours int `json:"expiresInHours"` // defaults to a week
+}
+
+// Preview is the draft a token points at; exactly one of Article and
+// Course is set
+type Preview struct {
+	Token   *PreviewToken
+	Article *Article
+	Course  *course.Course
+}
+
+type PreviewRepository interface {
+	Create(ctx context.Context, token *PreviewToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*PreviewToken, error)
+	GetByContent(ctx context.Context, contentType, contentID string) ([]*PreviewToken, error)
+	Revoke(ctx context.Context, id string) error
+}
+
+type PreviewUsecase interface {
+	CreateToken(ctx context.Context, contentType, contentID string, req CreatePreviewTokenRequest, createdBy string) (*PreviewToken, error)
+	GetTokens(ctx context.Context, contentType, contentID string) ([]*PreviewToken, error)
+	RevokeToken(ctx context.Context, id string) error
+	// GetPreview loads the content behind a token, published or not
+	GetPreview(ctx context.Context, token string) (*Preview, error)
+}
+
+// IsValidPreviewContentType reports whether preview links can be created for a content type
+func IsValidPreviewContentType(contentType string) bool {
+	return contentType == PreviewContentArticle || contentType == PreviewContentCourse
+}
+
+// NewPreviewToken returns a random URL-safe token and the hash stored for it
+func NewPreviewToken() (token, tokenHash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashPreviewToken(token), nil
+}
+
+// HashPreviewToken returns the hex SHA-256 a token is looked up by
+func HashPreviewToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -1,9 +1,12 @@
 package project
 
 import (
+	"errors"
 	"time"
 )
 
+var ErrProjectNotFound = errors.New("project not found")
+
 // Project represents a portfolio project entity
 type Project struct {
 	ID               string     `json:"id" db:"id"`
@@ -25,6 +28,12 @@ type Project struct {
 	UpdatedAt        time.Time  `json:"updatedAt" db:"updated_at"`
 }
 
+// ProjectPage is one keyset-paginated page of projects
+type ProjectPage struct {
+	Projects   []*Project `json:"projects"`
+	NextCursor string     `json:"nextCursor,omitempty"` // empty on the last page
+}
+
 // CreateProjectRequest represents create project request payload
 type CreateProjectRequest struct {
 	Title            string     `json:"title" binding:"required"`
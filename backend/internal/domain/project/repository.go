@@ -2,6 +2,8 @@ package project
 
 import (
 	"context"
+
+	"portfolio/internal/domain/bulk"
 )
 
 // Repository interface defines the contract for project data operations
@@ -12,7 +14,7 @@ type Repository interface {
 	// GetByID retrieves a project by ID
 	GetByID(ctx context.Context, id string) (*Project, error)
 
-	// GetBySlug retrieves a project by slug
+	// GetBySlug retrieves a published project by slug
 	GetBySlug(ctx context.Context, slug string) (*Project, error)
 
 	// Update updates a project
@@ -21,6 +23,10 @@ type Repository interface {
 	// Delete deletes a project
 	Delete(ctx context.Context, id string) error
 
-	// GetAll retrieves all projects with optional filters
+	// GetAll retrieves all projects with optional filters. The "after"
+	// (pagination.Cursor) and "limit" (int) filters select a keyset page.
 	GetAll(ctx context.Context, filters map[string]interface{}) ([]*Project, error)
+
+	// BulkUpdate applies one action to many projects in a single transaction
+	BulkUpdate(ctx context.Context, req bulk.Request) (*bulk.Report, error)
 }
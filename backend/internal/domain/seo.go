@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Content types that carry SEO metadata and admin overrides
+const (
+	SEOContentArticle = "article"
+	SEOContentProject = "project"
+	SEOContentCourse  = "course"
+)
+
+// SEODescriptionLength is the length generated descriptions are cut to
+const SEODescriptionLength = 160
+
+var (
+	ErrSEOPageNotFound        = errors.New("no public page at this path")
+	ErrInvalidSEOContentType  = errors.New("content type must be article, project or course")
+	ErrSEOOverrideNotFound    = errors.New("seo override not found")
+	ErrInvalidSEOCanonicalURL = errors.New("canonical URL must be an absolute http(s) URL")
+)
+
+// SEOMetadata is everything a page needs in its <head>: the canonical URL,
+// OpenGraph and Twitter card fields and a JSON-LD document
+type SEOMetadata struct {
+	Path          string                 `json:"path"`
+	ContentType   string                 `json:"contentType,omitempty"`
+	ContentID     string                 `json:"contentId,omitempty"`
+	CanonicalURL  string                 `json:"canonicalUrl"`
+	Title         string                 `json:"title"`
+	Description   string                 `json:"description"`
+	Image         string                 `json:"image"`
+	NoIndex       bool                   `json:"noIndex"`
+	OpenGraph     OpenGraphMetadata      `json:"openGraph"`
+	Twitter       TwitterCardMetadata    `json:"twitter"`
+	JSONLD        map[string]interface{} `json:"jsonLd"`
+	Overridden    []string               `json:"overridden,omitempty"` // fields taken from the admin override
+	PublishedTime *time.Time             `json:"publishedTime,omitempty"`
+	ModifiedTime  *time.Time             `json:"modifiedTime,omitempty"`
+}
+
+type OpenGraphMetadata struct {
+	Type        string   `json:"type"` // website or article
+	URL         string   `json:"url"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Image       string   `json:"image"`
+	SiteName    string   `json:"siteName"`
+	Author      string   `json:"author,omitempty"`
+	Section     string   `json:"section,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type TwitterCardMetadata struct {
+	Card        string `json:"card"` // summary_large_image, or summary without an image
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Image       string `json:"image,omitempty"`
+	Site        string `json:"site,omitempty"`
+	Creator     string `json:"creator,omitempty"`
+}
+
+// SEOOverride replaces generated metadata for one content item. Empty fields
+// keep the generated value.
+type SEOOverride struct {
+	ContentType  string    `json:"contentType" db:"content_type"`
+	ContentID    string    `json:"contentId" db:"content_id"`
+	Title        string    `json:"title" db:"title"`
+	Description  string    `json:"description" db:"description"`
+	Image        string    `json:"image" db:"image"`
+	CanonicalURL string    `json:"canonicalUrl" db:"canonical_url"`
+	NoIndex      bool      `json:"noIndex" db:"no_index"`
+	UpdatedAt    time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type SEOOverrideRequest struct {
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	Image        string `json:"image"`
+	CanonicalURL string `json:"canonicalUrl"`
+	NoIndex      bool   `json:"noIndex"`
+}
+
+type SEORepository interface {
+	GetOverride(ctx context.Context, contentType, contentID string) (*SEOOverride, error)
+	UpsertOverride(ctx context.Context, override *SEOOverride) error
+	DeleteOverride(ctx context.Context, contentType, contentID string) error
+}
+
+type SEOUsecase interface {
+	// GetMetadata resolves a public site path such as /articles/:slug
+	GetMetadata(ctx context.Context, path string) (*SEOMetadata, error)
+	GetOverride(ctx context.Context, contentType, contentID string) (*SEOOverride, error)
+	SetOverride(ctx context.Context, contentType, contentID string, req SEOOverrideRequest) (*SEOOverride, error)
+	DeleteOverride(ctx context.Context, contentType, contentID string) error
+}
+
+// IsValidSEOContentType reports whether overrides can be stored for a content type
+func IsValidSEOContentType(contentType string) bool {
+	switch contentType {
+	case SEOContentArticle, SEOContentProject, SEOContentCourse:
+		return true
+	}
+	return false
+}
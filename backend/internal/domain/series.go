@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Series groups articles into an ordered multi-part collection. An article
+// belongs to at most one series.
+type Series struct {
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Slug         string     `json:"slug"`
+	Description  string     `json:"description"`
+	ArticleCount int        `json:"articleCount"`
+	Articles     []*Article `json:"articles,omitempty"` // in series order
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// SeriesLink points at a neighbouring part of a series
+type SeriesLink struct {
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+// SeriesNavigation places an article within its series
+type SeriesNavigation struct {
+	ID       string      `json:"id"`
+	Title    string      `json:"title"`
+	Slug     string      `json:"slug"`
+	Part     int         `json:"part"`  // 1-based
+	Total    int         `json:"total"` // parts visible to the reader
+	Previous *SeriesLink `json:"previous,omitempty"`
+	Next     *SeriesLink `json:"next,omitempty"`
+}
+
+type CreateSeriesRequest struct {
+	Title       string   `json:"title" binding:"required"`
+	Description string   `json:"description"`
+	ArticleIDs  []string `json:"articleIds"`
+}
+
+type UpdateSeriesRequest struct {
+	Title       string    `json:"title,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	ArticleIDs  *[]string `json:"articleIds,omitempty"` // replaces the ordering when set
+}
+
+var (
+	ErrSeriesNotFound         = errors.New("series not found")
+	ErrArticleInAnotherSeries = errors.New("article already belongs to another series")
+	ErrInvalidSeriesArticles  = errors.New("series articles must be distinct existing articles")
+	ErrInvalidSeriesTitle     = errors.New("series title must contain letters or digits")
+)
+
+type SeriesRepository interface {
+	// GetAll lists series with part counts; publicOnly counts visible parts
+	// and leaves out series without any
+	GetAll(ctx context.Context, publicOnly bool) ([]*Series, error)
+	// GetByID, GetBySlug and GetByArticle load the ordered parts; publicOnly
+	// leaves out parts that are not publicly visible
+	GetByID(ctx context.Context, id string, publicOnly bool) (*Series, error)
+	GetBySlug(ctx context.Context, slug string, publicOnly bool) (*Series, error)
+	GetByArticle(ctx context.Context, articleID string, publicOnly bool) (*Series, error)
+	Create(ctx context.Context, series *Series, articleIDs []string) error
+	Update(ctx context.Context, series *Series, articleIDs *[]string) error
+	Delete(ctx context.Context, id string) error
+}
+
+type SeriesUsecase interface {
+	GetSeriesList(ctx context.Context, publicOnly bool) ([]*Series, error)
+	GetSeriesByID(ctx context.Context, id string) (*Series, error)
+	GetPublicSeries(ctx context.Context, slug string) (*Series, error)
+	CreateSeries(ctx context.Context, req CreateSeriesRequest) (*Series, error)
+	UpdateSeries(ctx context.Context, id string, req UpdateSeriesRequest) (*Series, error)
+	DeleteSeries(ctx context.Context, id string) error
+}
+
+// Navigation returns the position of an article among the loaded parts and
+// its neighbours. Parts missing from Articles (e.g. unpublished ones in a
+// public load) are skipped, so the links always point at readable parts.
+func (s *Series) Navigation(articleID string) *SeriesNavigation {
+	for i, article := range s.Articles {
+		if article.ID != articleID {
+			continue
+		}
+
+		nav := &SeriesNavigation{
+			ID:    s.ID,
+			Title: s.Title,
+			Slug:  s.Slug,
+			Part:  i + 1,
+			Total: len(s.Articles),
+		}
+		if i > 0 {
+			prev := s.Articles[i-1]
+			nav.Previous = &SeriesLink{Title: prev.Title, Slug: prev.Slug}
+		}
+		if i+1 < len(s.Articles) {
+			next := s.Articles[i+1]
+			nav.Next = &SeriesLink{Title: next.Title, Slug: next.Slug}
+		}
+		return nav
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Site describes the public website that feeds and other generated
+// documents link to
+type Site struct {
+	Name          string
+	URL           string
+	Description   string
+	Author        string // person behind the site, credited in JSON-LD
+	TwitterHandle string // e.g. "@handle"
+	DefaultImage  string // social image for pages without their own
+}
+
+// NewSite normalises the base URL so paths can be appended to it
+func NewSite(name, siteURL, description string) Site {
+	return Site{
+		Name:        name,
+		URL:         strings.TrimRight(siteURL, "/"),
+		Description: description,
+	}
+}
+
+// Link returns the absolute URL of a path on the site
+func (s Site) Link(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return s.URL + path
+}
+
+// ArticleURL returns the public URL of an article
+func (s Site) ArticleURL(slug string) string {
+	return s.Link("/articles/" + url.PathEscape(slug))
+}
+
+// AbsoluteURL resolves a URL that may be relative to the site
+func (s Site) AbsoluteURL(ref string) string {
+	if ref == "" || strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return ref
+	}
+	return s.Link(ref)
+}
+
+// ProjectURL returns the public URL of a project
+func (s Site) ProjectURL(slug string) string {
+	return s.Link("/projects/" + url.PathEscape(slug))
+}
+
+// CourseURL returns the public URL of a course
+func (s Site) CourseURL(slug string) string {
+	return s.Link("/courses/" + url.PathEscape(slug))
+}
+
+// AuthorURL returns the public URL of an author page
+func (s Site) AuthorURL(slug string) string {
+	return s.Link("/authors/" + url.PathEscape(slug))
+}
+
+// CategoryURL returns the public URL of the article list for a category
+func (s Site) CategoryURL(slug string) string {
+	return s.Link("/articles?category=" + url.QueryEscape(slug))
+}
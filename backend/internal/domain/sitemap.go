@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+// Sitemap protocol limits for a single file
+const (
+	SitemapMaxURLs  = 50000
+	SitemapMaxBytes = 50 * 1024 * 1024
+)
+
+// Sitemap sections, each served as one or more numbered files
+const (
+	SitemapPages      = "pages"
+	SitemapArticles   = "articles"
+	SitemapProjects   = "projects"
+	SitemapCourses    = "courses"
+	SitemapCategories = "categories"
+)
+
+// SitemapSections lists the sections in the order they appear in the index
+var SitemapSections = []string{SitemapPages, SitemapArticles, SitemapProjects, SitemapCourses, SitemapCategories}
+
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+// SitemapEntry is a publicly visible item and when it last changed
+type SitemapEntry struct {
+	Slug    string    `db:"slug"`
+	LastMod time.Time `db:"lastmod"`
+}
+
+// SitemapURL is one <url> of a sitemap; LastMod is left out when zero
+type SitemapURL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// SitemapFile is one file of the sitemap index
+type SitemapFile struct {
+	Name    string // e.g. "articles-1.xml"
+	Loc     string
+	LastMod time.Time
+}
+
+type SitemapRepository interface {
+	GetArticleEntries(ctx context.Context) ([]SitemapEntry, error)
+	GetProjectEntries(ctx context.Context) ([]SitemapEntry, error)
+	GetCourseEntries(ctx context.Context) ([]SitemapEntry, error)
+	// GetCategoryEntries returns categories with visible articles, dated by
+	// their most recently updated article
+	GetCategoryEntries(ctx context.Context) ([]SitemapEntry, error)
+}
+
+type SitemapUsecase interface {
+	GetSitemapIndex(ctx context.Context) ([]SitemapFile, error)
+	// GetSitemap returns the URLs of one file of a section; pages are 1-based
+	GetSitemap(ctx context.Context, section string, page int) ([]SitemapURL, error)
+	GetRobotsTxt() string
+}
+
+// approximate size of a <url> element without its location
+const sitemapURLOverhead = len("<url><loc></loc><lastmod>2006-01-02T15:04:05Z</lastmod></url>\n")
+
+// SplitSitemapURLs chunks URLs so that no file exceeds the protocol limits
+// on URL count or size
+func SplitSitemapURLs(urls []SitemapURL) [][]SitemapURL {
+	headerSize := len(xml.Header) + len(`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`)
+
+	var chunks [][]SitemapURL
+	start, size := 0, headerSize
+	for i, u := range urls {
+		// Escaping can grow a location, so count it at worst case
+		urlSize := sitemapURLOverhead + len(u.Loc)*6
+		if i > start && (i-start >= SitemapMaxURLs || size+urlSize > SitemapMaxBytes) {
+			chunks = append(chunks, urls[start:i])
+			start, size = i, headerSize
+		}
+		size += urlSize
+	}
+	if start < len(urls) {
+		chunks = append(chunks, urls[start:])
+	}
+	return chunks
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name        `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapXMLURL `xml:"url"`
+}
+
+type sitemapXMLURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndexXML struct {
+	XMLName  xml.Name        `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []sitemapXMLURL `xml:"sitemap"`
+}
+
+// EncodeSitemap renders a <urlset> document
+func EncodeSitemap(urls []SitemapURL) ([]byte, error) {
+	set := sitemapURLSet{URLs: make([]sitemapXMLURL, len(urls))}
+	for i, u := range urls {
+		set.URLs[i] = sitemapXMLURL{Loc: u.Loc, LastMod: sitemapDate(u.LastMod)}
+	}
+	return encodeXML(set)
+}
+
+// EncodeSitemapIndex renders a <sitemapindex> document
+func EncodeSitemapIndex(files []SitemapFile) ([]byte, error) {
+	index := sitemapIndexXML{Sitemaps: make([]sitemapXMLURL, len(files))}
+	for i, f := range files {
+		index.Sitemaps[i] = sitemapXMLURL{Loc: f.Loc, LastMod: sitemapDate(f.LastMod)}
+	}
+	return encodeXML(index)
+}
+
+func sitemapDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Content types that keep a history of their old slugs
+const (
+	SlugContentArticle = "article"
+	SlugContentProject = "project"
+	SlugContentCourse  = "course"
+)
+
+var ErrSlugRedirectNotFound = errors.New("slug redirect not found")
+
+// SlugRedirect is returned instead of content requested by a slug it no
+// longer uses; Slug is the current one. It is an error so the slug lookups
+// keep their signatures, and handlers answer it with a 301.
+type SlugRedirect struct {
+	ContentType string `json:"type"`
+	From        string `json:"from"`
+	Slug        string `json:"slug"`
+}
+
+func (r *SlugRedirect) Error() string {
+	return fmt.Sprintf("%s %q moved to %q", r.ContentType, r.From, r.Slug)
+}
+
+// SlugRedirectRepository looks up old slugs. Old slugs are recorded by the
+// database whenever a slug changes.
+type SlugRedirectRepository interface {
+	// Resolve returns the current slug of the content that used to be at slug
+	Resolve(ctx context.Context, contentType, slug string) (string, error)
+}
+
+// ResolveSlugRedirect turns a not-found lookup by slug into a redirect when
+// the slug is an old one. Any other error, or a slug without a redirect,
+// returns notFound unchanged.
+func ResolveSlugRedirect(ctx context.Context, repo SlugRedirectRepository, contentType, slug string, notFound error) error {
+	current, err := repo.Resolve(ctx, contentType, slug)
+	if err != nil {
+		if err == ErrSlugRedirectNotFound {
+			return notFound
+		}
+		return err
+	}
+	return &SlugRedirect{ContentType: contentType, From: slug, Slug: current}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Tag is the canonical form of a free-text article tag. Tags with the same
+// slug are the same tag, so "Go" and "go" collapse into one.
+type Tag struct {
+	ID           string    `json:"id" db:"id"`
+	Name         string    `json:"name" db:"name"`
+	Slug         string    `json:"slug" db:"slug"`
+	Description  string    `json:"description" db:"description"`
+	ArticleCount int       `json:"articleCount" db:"article_count"`
+	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type UpdateTagRequest struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type MergeTagsRequest struct {
+	SourceIDs []string `json:"sourceIds" binding:"required,min=1"`
+	TargetID  string   `json:"targetId" binding:"required"`
+}
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("a tag with this name already exists; merge the tags instead")
+	ErrInvalidTagName   = errors.New("tag name must contain letters or digits")
+	ErrInvalidTagMerge  = errors.New("cannot merge a tag into itself")
+)
+
+// ValidateTagNames rejects tag names that have no slug, such as "!!!",
+// instead of dropping them silently
+func ValidateTagNames(names []string) error {
+	for _, name := range names {
+		if Slugify(name) == "" {
+			return ErrInvalidTagName
+		}
+	}
+	return nil
+}
+
+type TagRepository interface {
+	// GetAll lists tags with usage counts; publicOnly counts only publicly
+	// visible articles and leaves out unused tags
+	GetAll(ctx context.Context, publicOnly bool) ([]*Tag, error)
+	GetByID(ctx context.Context, id string) (*Tag, error)
+	GetBySlug(ctx context.Context, slug string) (*Tag, error)
+	// Update renames a tag and rewrites its article_tags rows in one transaction
+	Update(ctx context.Context, tag *Tag) error
+	// Merge moves every article of the source tags onto target and deletes the sources
+	Merge(ctx context.Context, sourceIDs []string, targetID string) error
+	Delete(ctx context.Context, id string) error
+}
+
+type TagUsecase interface {
+	GetTags(ctx context.Context, publicOnly bool) ([]*Tag, error)
+	GetTagBySlug(ctx context.Context, slug string) (*Tag, error)
+	GetTagArticles(ctx context.Context, slug string, page, limit int) (*Tag, *ArticleListResult, error)
+	UpdateTag(ctx context.Context, id string, req UpdateTagRequest) (*Tag, error)
+	MergeTags(ctx context.Context, req MergeTagsRequest) (*Tag, error)
+	DeleteTag(ctx context.Context, id string) error
+}
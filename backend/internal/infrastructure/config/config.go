@@ -25,6 +25,17 @@ type Config struct {
 
 	// CORS
 	AllowedOrigins []string
+
+	// Public site, used for links in feeds and sitemaps and for SEO metadata
+	SiteURL           string
+	SiteName          string
+	SiteDescription   string
+	SiteAuthor        string
+	SiteTwitterHandle string
+	SiteDefaultImage  string
+
+	// Scheduler
+	PublishSchedulerInterval int // seconds
 }
 
 // New creates a new Config instance from environment variables
@@ -50,6 +61,17 @@ func New() *Config {
 		AllowedOrigins: []string{
 			getEnv("FRONTEND_URL", "http://localhost:5173"),
 		},
+
+		// Public site
+		SiteURL:           getEnv("SITE_URL", getEnv("FRONTEND_URL", "http://localhost:5173")),
+		SiteName:          getEnv("SITE_NAME", "Portfolio"),
+		SiteDescription:   getEnv("SITE_DESCRIPTION", "Articles, projects and courses"),
+		SiteAuthor:        getEnv("SITE_AUTHOR", getEnv("SITE_NAME", "Portfolio")),
+		SiteTwitterHandle: getEnv("SITE_TWITTER_HANDLE", ""),
+		SiteDefaultImage:  getEnv("SITE_DEFAULT_IMAGE", "/og-image.jpg"),
+
+		// Scheduler
+		PublishSchedulerInterval: getEnvAsInt("PUBLISH_SCHEDULER_INTERVAL", 30),
 	}
 }
 
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type analyticsPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewAnalyticsPostgresRepository(db *sqlx.DB) domain.AnalyticsRepository {
+	return &analyticsPostgresRepository{
+		db: db,
+	}
+}
+
+// rollupFilter restricts a rollup alias to the requested days and, when set,
+// one article. It uses $1 to $3; the limit, if any, goes in $4.
+func rollupFilter(alias string) string {
+	return fmt.Sprintf(`%[1]s.day BETWEEN $1::date AND $2::date AND ($3 = '' OR %[1]s.article_id = $3)`, alias)
+}
+
+func rollupArgs(params domain.ArticleAnalyticsParams) []interface{} {
+	return []interface{}{params.From, params.To, params.ArticleID}
+}
+
+func (r *analyticsPostgresRepository) GetDailyTraffic(ctx context.Context, params domain.ArticleAnalyticsParams) ([]domain.DailyTraffic, error) {
+	query := `
+		SELECT to_char(ds.day, 'YYYY-MM-DD') AS day, SUM(ds.views) AS views, SUM(ds.likes) AS likes
+		FROM article_daily_stats ds
+		WHERE ` + rollupFilter("ds") + `
+		GROUP BY ds.day
+		ORDER BY ds.day`
+
+	var days []domain.DailyTraffic
+	err := r.db.SelectContext(ctx, &days, query, rollupArgs(params)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily traffic: %w", err)
+	}
+
+	return days, nil
+}
+
+func (r *analyticsPostgresRepository) GetTopArticles(ctx context.Context, params domain.ArticleAnalyticsParams) ([]domain.ArticleTraffic, error) {
+	query := `
+		SELECT a.id AS article_id, a.title, a.slug, c.slug AS category_slug,
+			SUM(ds.views) AS views, SUM(ds.likes) AS likes
+		FROM article_daily_stats ds
+		INNER JOIN articles a ON a.id = ds.article_id
+		INNER JOIN categories c ON c.id = a.category_id
+		WHERE ` + rollupFilter("ds") + `
+		GROUP BY a.id, a.title, a.slug, c.slug
+		ORDER BY views DESC, likes DESC, a.title
+		LIMIT $4`
+
+	var articles []domain.ArticleTraffic
+	err := r.db.SelectContext(ctx, &articles, query, append(rollupArgs(params), params.Limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top articles: %w", err)
+	}
+
+	return articles, nil
+}
+
+func (r *analyticsPostgresRepository) GetCategoryTraffic(ctx context.Context, params domain.ArticleAnalyticsParams) ([]domain.CategoryTraffic, error) {
+	query := `
+		SELECT c.id AS category_id, c.name, c.slug, COUNT(DISTINCT ds.article_id) AS article_count,
+			SUM(ds.views) AS views, SUM(ds.likes) AS likes
+		FROM article_daily_stats ds
+		INNER JOIN articles a ON a.id = ds.article_id
+		INNER JOIN categories c ON c.id = a.category_id
+		WHERE ` + rollupFilter("ds") + `
+		GROUP BY c.id, c.name, c.slug
+		ORDER BY views DESC, c.name`
+
+	var categories []domain.CategoryTraffic
+	err := r.db.SelectContext(ctx, &categories, query, rollupArgs(params)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category traffic: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *analyticsPostgresRepository) GetTopReferrers(ctx context.Context, params domain.ArticleAnalyticsParams) ([]domain.ReferrerTraffic, error) {
+	query := `
+		SELECT dr.domain, SUM(dr.views) AS views
+		FROM article_daily_referrers dr
+		WHERE ` + rollupFilter("dr") + `
+		GROUP BY dr.domain
+		ORDER BY views DESC, dr.domain
+		LIMIT $4`
+
+	var referrers []domain.ReferrerTraffic
+	err := r.db.SelectContext(ctx, &referrers, query, append(rollupArgs(params), params.Limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top referrers: %w", err)
+	}
+
+	return referrers, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Bulk applies one action to many articles in a single transaction.
+// Publishing and unpublishing follow the editorial workflow and are recorded
+// as transitions of the actor; an article publishable refuses fails its item.
+func (r *articlePostgresRepository) Bulk(ctx context.Context, req bulk.Request, actor domain.Actor, publishable func(content string) error) (*bulk.Report, error) {
+	categoryID := ""
+	if req.Action == bulk.ActionChangeCategory {
+		err := r.db.GetContext(ctx, &categoryID,
+			`SELECT id FROM categories WHERE id::text = $1 OR slug = $1`, req.Value)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, domain.ErrCategoryNotFound
+			}
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+	}
+
+	return runBulk(ctx, r.db, req.Action, req.IDs, func(tx *sqlx.Tx, id string) (bool, error) {
+		var status string
+		err := tx.GetContext(ctx, &status, `SELECT status FROM articles WHERE id = $1 FOR UPDATE`, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, domain.ErrArticleNotFound
+			}
+			return false, err
+		}
+
+		switch req.Action {
+		case bulk.ActionPublish:
+			if status == domain.ArticleStatusPublished {
+				return false, nil
+			}
+			var content sql.NullString
+			if err := tx.GetContext(ctx, &content, `SELECT content FROM articles WHERE id = $1`, id); err != nil {
+				return false, err
+			}
+			if err := publishable(content.String); err != nil {
+				return false, err
+			}
+			return true, r.bulkTransition(ctx, tx, id, status, domain.ArticleActionPublish, actor)
+		case bulk.ActionUnpublish:
+			if status != domain.ArticleStatusPublished {
+				return false, nil
+			}
+			return true, r.bulkTransition(ctx, tx, id, status, domain.ArticleActionArchive, actor)
+		case bulk.ActionFeature, bulk.ActionUnfeature:
+			featured := req.Action == bulk.ActionFeature
+			return affected(ctx, tx, `
+				UPDATE articles SET featured = $2, updated_at = NOW()
+				WHERE id = $1 AND featured <> $2`, id, featured)
+		case bulk.ActionChangeCategory:
+			return affected(ctx, tx, `
+				UPDATE articles SET category_id = $2, updated_at = NOW()
+				WHERE id = $1 AND category_id <> $2`, id, categoryID)
+		case bulk.ActionAddTag:
+			var tagged bool
+			err := tx.GetContext(ctx, &tagged, `
+				SELECT EXISTS (SELECT 1 FROM article_tags at INNER JOIN tags t ON t.id = at.tag_id
+				WHERE at.article_id = $1 AND t.slug = $2)`, id, domain.Slugify(req.Value))
+			if err != nil || tagged {
+				return false, err
+			}
+			return true, r.insertArticleTags(ctx, tx, id, []string{req.Value})
+		case bulk.ActionRemoveTag:
+			return affected(ctx, tx, `
+				DELETE FROM article_tags at USING tags t
+				WHERE at.tag_id = t.id AND at.article_id = $1 AND t.slug = $2`, id, domain.Slugify(req.Value))
+		case bulk.ActionDelete:
+			if _, err := tx.ExecContext(ctx, "DELETE FROM article_tags WHERE article_id = $1", id); err != nil {
+				return false, err
+			}
+			return affected(ctx, tx, "DELETE FROM articles WHERE id = $1", id)
+		}
+
+		return false, bulk.ErrUnsupportedAction
+	})
+}
+
+// bulkTransition moves an article through the workflow inside a batch and
+// records the step in its history
+func (r *articlePostgresRepository) bulkTransition(ctx context.Context, tx *sqlx.Tx, id, fromStatus, action string, actor domain.Actor) error {
+	toStatus, err := domain.NextArticleStatus(fromStatus, action, actor)
+	if err != nil {
+		return err
+	}
+
+	setParts := []string{"status = $2", "published = $3", "publish_at = NULL", "updated_at = NOW()"}
+	if toStatus == domain.ArticleStatusPublished {
+		setParts = append(setParts, "published_at = CASE WHEN published THEN published_at ELSE NOW() END")
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE articles SET `+strings.Join(setParts, ", ")+` WHERE id = $1`,
+		id, toStatus, toStatus == domain.ArticleStatusPublished)
+	if err != nil {
+		return err
+	}
+
+	return recordArticleTransition(ctx, tx, &domain.ArticleTransition{
+		ID:         uuid.New().String(),
+		ArticleID:  id,
+		Action:     action,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    actor.ID,
+		CreatedAt:  time.Now(),
+	})
+}
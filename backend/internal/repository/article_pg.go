@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"html"
 	"strings"
 	"time"
 
 	"portfolio/internal/domain"
+	"portfolio/internal/domain/pagination"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type articlePostgresRepository struct {
@@ -22,12 +25,52 @@ func NewArticlePostgresRepository(db *sqlx.DB) domain.ArticleRepository {
 	}
 }
 
+// articleColumns and articleFrom make up articleSelect, which selects the
+// columns of articleDB; callers append WHERE/ORDER clauses
+const articleColumns = `
+			a.id, a.title, a.excerpt, a.content, a.language, a.thumbnail, a.published_at, a.updated_at,
+			a.content_updated_at, a.read_time, a.slug, a.featured, a.published, a.status, a.publish_at, a.unpublish_at,
+			a.view_count, a.like_count,
+			c.id as category_id, c.name as category_name, c.color as category_color,
+			c.bg_color as category_bg_color, c.slug as category_slug,
+			u.id as author_id, u.name as author_name, u.email as author_email,
+			ap.slug as author_slug, ap.avatar as author_avatar, ap.bio as author_bio`
+
+const articleFrom = `
+		FROM articles a
+		INNER JOIN categories c ON a.category_id = c.id
+		INNER JOIN users u ON a.author_id = u.id
+		LEFT JOIN author_profiles ap ON ap.user_id = u.id`
+
+const articleSelect = `
+		SELECT ` + articleColumns + articleFrom
+
+// searchVectorColumns holds the generated, weighted tsvector column for
+// each article language (title A, excerpt B, body text C)
+var searchVectorColumns = map[string]string{
+	domain.LanguageEnglish:    "a.search_vector_en",
+	domain.LanguageIndonesian: "a.search_vector_id",
+}
+
+// Headline markers are swapped for <mark> after HTML-escaping the snippet
+const (
+	headlineStart = "[[[hl]]]"
+	headlineStop  = "[[[/hl]]]"
+)
+
+// articleVisible matches articles the public may see. It honours publish_at and
+// unpublish_at directly so the schedule holds even before the scheduler has run;
+// only approved articles go live on their publish_at. Article.IsPublic is
+// the same rule in Go.
+const articleVisible = `(a.published = true OR (a.status = 'approved' AND COALESCE(a.publish_at <= NOW(), false))) AND COALESCE(a.unpublish_at > NOW(), true)`
+
 // Article database model
 type articleDB struct {
 	ID              string         `db:"id"`
 	Title           string         `db:"title"`
 	Excerpt         string         `db:"excerpt"`
 	Content         sql.NullString `db:"content"`
+	Language        string         `db:"language"`
 	Thumbnail       sql.NullString `db:"thumbnail"`
 	CategoryID      string         `db:"category_id"`
 	CategoryName    string         `db:"category_name"`
@@ -36,14 +79,20 @@ type articleDB struct {
 	CategorySlug    string         `db:"category_slug"`
 	PublishedAt     time.Time      `db:"published_at"`
 	UpdatedAt       time.Time      `db:"updated_at"`
+	ContentUpdated  time.Time      `db:"content_updated_at"`
 	ReadTime        int            `db:"read_time"`
 	Slug            string         `db:"slug"`
 	Featured        bool           `db:"featured"`
 	Published       bool           `db:"published"`
+	Status          string         `db:"status"`
+	PublishAt       *time.Time     `db:"publish_at"`
+	UnpublishAt     *time.Time     `db:"unpublish_at"`
 	AuthorID        string         `db:"author_id"`
 	AuthorName      string         `db:"author_name"`
 	AuthorEmail     sql.NullString `db:"author_email"`
+	AuthorSlug      sql.NullString `db:"author_slug"`
 	AuthorAvatar    sql.NullString `db:"author_avatar"`
+	AuthorBio       sql.NullString `db:"author_bio"`
 	ViewCount       int            `db:"view_count"`
 	LikeCount       int            `db:"like_count"`
 }
@@ -60,6 +109,56 @@ func (r *articlePostgresRepository) GetAll(ctx context.Context, params domain.Ar
 		argIndex++
 	}
 
+	if len(params.Tags) > 0 {
+		if params.TagMatch == domain.TagMatchAll {
+			conditions = append(conditions, fmt.Sprintf(
+				"(SELECT COUNT(DISTINCT t.slug) FROM article_tags at INNER JOIN tags t ON t.id = at.tag_id WHERE at.article_id = a.id AND t.slug = ANY($%d)) = $%d", argIndex, argIndex+1))
+			args = append(args, pq.Array(params.Tags), distinctCount(params.Tags))
+			argIndex += 2
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM article_tags at INNER JOIN tags t ON t.id = at.tag_id WHERE at.article_id = a.id AND t.slug = ANY($%d))", argIndex))
+			args = append(args, pq.Array(params.Tags))
+			argIndex++
+		}
+	}
+
+	if params.AuthorID != "" {
+		conditions = append(conditions, fmt.Sprintf("a.author_id::text = $%d", argIndex))
+		args = append(args, params.AuthorID)
+		argIndex++
+	}
+
+	if params.Author != "" {
+		conditions = append(conditions, fmt.Sprintf("ap.slug = $%d", argIndex))
+		args = append(args, params.Author)
+		argIndex++
+	}
+
+	if params.From != nil {
+		conditions = append(conditions, fmt.Sprintf("a.published_at >= $%d", argIndex))
+		args = append(args, *params.From)
+		argIndex++
+	}
+
+	if params.To != nil {
+		conditions = append(conditions, fmt.Sprintf("a.published_at < $%d", argIndex))
+		args = append(args, *params.To)
+		argIndex++
+	}
+
+	if params.MinReadTime > 0 {
+		conditions = append(conditions, fmt.Sprintf("a.read_time >= $%d", argIndex))
+		args = append(args, params.MinReadTime)
+		argIndex++
+	}
+
+	if params.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("a.status = $%d", argIndex))
+		args = append(args, params.Status)
+		argIndex++
+	}
+
 	if params.Featured != nil {
 		conditions = append(conditions, fmt.Sprintf("a.featured = $%d", argIndex))
 		args = append(args, *params.Featured)
@@ -69,9 +168,20 @@ func (r *articlePostgresRepository) GetAll(ctx context.Context, params domain.Ar
 	// Only filter by published status if explicitly provided
 	// This allows admin to see all articles when Published is nil
 	if params.Published != nil {
-		conditions = append(conditions, fmt.Sprintf("a.published = $%d", argIndex))
-		args = append(args, *params.Published)
-		argIndex++
+		if *params.Published {
+			conditions = append(conditions, "("+articleVisible+")")
+		} else {
+			conditions = append(conditions, "NOT ("+articleVisible+")")
+		}
+	}
+
+	sort, ok := articleSorts[params.Sort]
+	if !ok {
+		sort = articleSorts[domain.ArticleSortNewest]
+	}
+
+	if params.Cursor != nil {
+		return r.getPageAfter(ctx, conditions, args, sort, *params.Cursor, params.Limit)
 	}
 
 	whereClause := ""
@@ -84,9 +194,7 @@ func (r *articlePostgresRepository) GetAll(ctx context.Context, params domain.Ar
 
 	// Get total count
 	countQuery := fmt.Sprintf(`
-		SELECT COUNT(*) FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
+		SELECT COUNT(*)`+articleFrom+`
 		%s`, whereClause)
 
 	var total int
@@ -96,19 +204,10 @@ func (r *articlePostgresRepository) GetAll(ctx context.Context, params domain.Ar
 	}
 
 	// Get articles
-	query := fmt.Sprintf(`
-		SELECT 
-			a.id, a.title, a.excerpt, a.content, a.thumbnail, a.published_at, a.updated_at,
-			a.read_time, a.slug, a.featured, a.published, a.view_count, a.like_count,
-			c.id as category_id, c.name as category_name, c.color as category_color,
-			c.bg_color as category_bg_color, c.slug as category_slug,
-			u.id as author_id, u.name as author_name, u.email as author_email
-		FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
+	query := fmt.Sprintf(articleSelect+`
 		%s
-		ORDER BY a.published_at DESC
-		LIMIT $%d OFFSET $%d`, whereClause, argIndex, argIndex+1)
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d`, whereClause, sort.orderBy, argIndex, argIndex+1)
 
 	args = append(args, params.Limit, offset)
 
@@ -135,17 +234,101 @@ func (r *articlePostgresRepository) GetAll(ctx context.Context, params domain.Ar
 	}, nil
 }
 
+// articleSort is an order of the article list. after continues a keyset
+// page past a cursor holding the publish time, with the ID as tie-breaker;
+// rankings have no keyset mode, since they move between requests.
+type articleSort struct {
+	name    string
+	orderBy string
+	after   string
+}
+
+// trendingScore weighs the recent daily views and likes of an article
+var trendingScore = fmt.Sprintf(`COALESCE((
+			SELECT SUM(ds.views + %d * ds.likes) FROM article_daily_stats ds
+			WHERE ds.article_id = a.id AND ds.day > CURRENT_DATE - %d), 0)`,
+	domain.TrendingLikeWeight, domain.TrendingWindowDays)
+
+var articleSorts = map[string]articleSort{
+	domain.ArticleSortNewest: {
+		name:    domain.ArticleSortNewest,
+		orderBy: "a.published_at DESC, a.id DESC",
+		after:   "(a.published_at, a.id) < ($%d, $%d)",
+	},
+	domain.ArticleSortOldest: {
+		name:    domain.ArticleSortOldest,
+		orderBy: "a.published_at ASC, a.id ASC",
+		after:   "(a.published_at, a.id) > ($%d, $%d)",
+	},
+	domain.ArticleSortMostViewed: {
+		name:    domain.ArticleSortMostViewed,
+		orderBy: "a.view_count DESC, a.id DESC",
+	},
+	domain.ArticleSortMostLiked: {
+		name:    domain.ArticleSortMostLiked,
+		orderBy: "a.like_count DESC, a.id DESC",
+	},
+	domain.ArticleSortTrending: {
+		name:    domain.ArticleSortTrending,
+		orderBy: trendingScore + " DESC, a.published_at DESC, a.id DESC",
+	},
+}
+
+// distinctCount is the number of different values in values
+func distinctCount(values []string) int {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		seen[v] = true
+	}
+	return len(seen)
+}
+
+// getPageAfter is the keyset mode of GetAll: the limit articles after the
+// cursor in sort order, with one more fetched to tell whether a next page exists
+func (r *articlePostgresRepository) getPageAfter(ctx context.Context, conditions []string, args []interface{}, sort articleSort, cursor pagination.Cursor, limit int) (*domain.ArticleListResult, error) {
+	if sort.after == "" {
+		return nil, domain.ErrCursorWithRanking
+	}
+
+	if !cursor.IsStart() {
+		conditions = append(conditions, fmt.Sprintf(sort.after, len(args)+1, len(args)+2))
+		args = append(args, cursor.Time, cursor.ID)
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(articleSelect+`
+		%s
+		ORDER BY %s
+		LIMIT $%d`, whereClause, sort.orderBy, len(args)+1)
+	args = append(args, limit+1)
+
+	var articleDBs []articleDB
+	if err := r.db.SelectContext(ctx, &articleDBs, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get articles: %w", err)
+	}
+
+	result := &domain.ArticleListResult{}
+	if len(articleDBs) > limit {
+		articleDBs = articleDBs[:limit]
+		last := articleDBs[limit-1]
+		next := pagination.Cursor{Time: last.PublishedAt, ID: last.ID, Sort: sort.name}
+		result.NextCursor = next.Encode()
+	}
+
+	result.Articles = make([]*domain.Article, len(articleDBs))
+	for i := range articleDBs {
+		result.Articles[i] = r.dbToArticle(&articleDBs[i])
+	}
+
+	return result, nil
+}
+
 func (r *articlePostgresRepository) GetByID(ctx context.Context, id string) (*domain.Article, error) {
-	query := `
-		SELECT 
-			a.id, a.title, a.excerpt, a.content, a.thumbnail, a.published_at, a.updated_at,
-			a.read_time, a.slug, a.featured, a.published, a.view_count, a.like_count,
-			c.id as category_id, c.name as category_name, c.color as category_color,
-			c.bg_color as category_bg_color, c.slug as category_slug,
-			u.id as author_id, u.name as author_name, u.email as author_email
-		FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
+	query := articleSelect + `
 		WHERE a.id = $1`
 
 	var articleDB articleDB
@@ -170,17 +353,8 @@ func (r *articlePostgresRepository) GetByID(ctx context.Context, id string) (*do
 }
 
 func (r *articlePostgresRepository) GetBySlug(ctx context.Context, slug string) (*domain.Article, error) {
-	query := `
-		SELECT 
-			a.id, a.title, a.excerpt, a.content, a.thumbnail, a.published_at, a.updated_at,
-			a.read_time, a.slug, a.featured, a.published, a.view_count, a.like_count,
-			c.id as category_id, c.name as category_name, c.color as category_color,
-			c.bg_color as category_bg_color, c.slug as category_slug,
-			u.id as author_id, u.name as author_name, u.email as author_email
-		FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
-		WHERE a.slug = $1 AND a.published = true`
+	query := articleSelect + `
+		WHERE a.slug = $1 AND ` + articleVisible
 
 	var articleDB articleDB
 	err := r.db.GetContext(ctx, &articleDB, query, slug)
@@ -204,17 +378,8 @@ func (r *articlePostgresRepository) GetBySlug(ctx context.Context, slug string)
 }
 
 func (r *articlePostgresRepository) GetFeatured(ctx context.Context) (*domain.Article, error) {
-	query := `
-		SELECT 
-			a.id, a.title, a.excerpt, a.content, a.thumbnail, a.published_at, a.updated_at,
-			a.read_time, a.slug, a.featured, a.published, a.view_count, a.like_count,
-			c.id as category_id, c.name as category_name, c.color as category_color,
-			c.bg_color as category_bg_color, c.slug as category_slug,
-			u.id as author_id, u.name as author_name, u.email as author_email
-		FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
-		WHERE a.featured = true AND a.published = true
+	query := articleSelect + `
+		WHERE a.featured = true AND ` + articleVisible + `
 		ORDER BY a.published_at DESC
 		LIMIT 1`
 
@@ -240,54 +405,116 @@ func (r *articlePostgresRepository) GetFeatured(ctx context.Context) (*domain.Ar
 }
 
 func (r *articlePostgresRepository) Search(ctx context.Context, params domain.SearchParams) (*domain.ArticleListResult, error) {
-	searchTerm := "%" + strings.ToLower(params.Query) + "%"
+	// Search the requested language, or every language when none was given
+	languages := []string{domain.LanguageEnglish, domain.LanguageIndonesian}
+	if params.Language != "" {
+		languages = []string{params.Language}
+	}
+
+	var queries, matches, ranks, headlines []string
+	for _, lang := range languages {
+		config := domain.SearchConfig(lang)
+		column := searchVectorColumns[lang]
+		queries = append(queries, fmt.Sprintf("websearch_to_tsquery('%s', $1) q_%s", config, lang))
+		matches = append(matches, fmt.Sprintf("%s @@ q_%s", column, lang))
+		ranks = append(ranks, fmt.Sprintf("ts_rank(%s, q_%s)", column, lang))
+		headlines = append(headlines, fmt.Sprintf(
+			"ts_headline('%s', a.content_text, q_%s, 'MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" ... \", StartSel=%s, StopSel=%s')",
+			config, lang, headlineStart, headlineStop))
+	}
+
+	conditions := []string{articleVisible, "(" + strings.Join(matches, " OR ") + ")"}
+	args := []interface{}{params.Query}
+	argIndex := 2
+
+	rank := ranks[0]
+	headline := headlines[0]
+	if len(languages) > 1 {
+		rank = "GREATEST(" + strings.Join(ranks, ", ") + ")"
+		// Highlight with the configuration of the article's own language
+		headline = "CASE a.language"
+		for i, lang := range languages[1:] {
+			headline += fmt.Sprintf(" WHEN '%s' THEN %s", lang, headlines[i+1])
+		}
+		headline += " ELSE " + headlines[0] + " END"
+	}
+
+	if params.Category != "" {
+		conditions = append(conditions, fmt.Sprintf("c.slug = $%d", argIndex))
+		args = append(args, params.Category)
+		argIndex++
+	}
+
+	if params.Tag != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM article_tags t WHERE t.article_id = a.id AND LOWER(t.name) = LOWER($%d))", argIndex))
+		args = append(args, params.Tag)
+		argIndex++
+	}
+
+	if params.From != nil {
+		conditions = append(conditions, fmt.Sprintf("a.published_at >= $%d", argIndex))
+		args = append(args, *params.From)
+		argIndex++
+	}
+
+	if params.To != nil {
+		conditions = append(conditions, fmt.Sprintf("a.published_at < $%d", argIndex))
+		args = append(args, *params.To)
+		argIndex++
+	}
+
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+	queryFrom := articleFrom + ",\n\t\t" + strings.Join(queries, ", ") + "\n\t\t" + whereClause
 	offset := (params.Page - 1) * params.Limit
 
 	// Count total results
-	countQuery := `
-		SELECT COUNT(*) FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		WHERE a.published = true AND (
-			LOWER(a.title) LIKE $1 OR 
-			LOWER(a.excerpt) LIKE $1 OR 
-			LOWER(a.content) LIKE $1
-		)`
-
 	var total int
-	err := r.db.GetContext(ctx, &total, countQuery, searchTerm)
+	err := r.db.GetContext(ctx, &total, "SELECT COUNT(*)"+queryFrom, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count search results: %w", err)
 	}
 
-	// Get search results
-	query := `
-		SELECT 
-			a.id, a.title, a.excerpt, a.content, a.published_at, a.updated_at,
-			a.read_time, a.slug, a.featured, a.published, a.view_count, a.like_count,
-			c.id as category_id, c.name as category_name, c.color as category_color,
-			c.bg_color as category_bg_color, c.slug as category_slug,
-			u.id as author_id, u.name as author_name, u.email as author_email
-		FROM articles a
-		INNER JOIN categories c ON a.category_id = c.id
-		INNER JOIN users u ON a.author_id = u.id
-		WHERE a.published = true AND (
-			LOWER(a.title) LIKE $1 OR 
-			LOWER(a.excerpt) LIKE $1 OR 
-			LOWER(a.content) LIKE $1
-		)
-		ORDER BY a.published_at DESC
-		LIMIT $2 OFFSET $3`
+	// Articles written in the preferred language rank ahead of the rest;
+	// the argument is only bound for the ranked query, not the count
+	prefer := params.Language
+	if prefer == "" {
+		prefer = params.Prefer
+	}
+	if prefer != "" {
+		rank = fmt.Sprintf("%s * CASE WHEN a.language = $%d THEN 1.0 ELSE 0.5 END", rank, argIndex)
+		args = append(args, prefer)
+		argIndex++
+	}
 
-	var articleDBs []articleDB
-	err = r.db.SelectContext(ctx, &articleDBs, query, searchTerm, params.Limit, offset)
+	// Get ranked results with highlighted snippets
+	query := fmt.Sprintf(`
+		SELECT %s,
+			%s AS rank,
+			%s AS headline
+		%s
+		ORDER BY rank DESC, a.published_at DESC
+		LIMIT $%d OFFSET $%d`,
+		articleColumns, rank, headline, queryFrom, argIndex, argIndex+1)
+
+	args = append(args, params.Limit, offset)
+
+	var hits []struct {
+		articleDB
+		Rank     float64 `db:"rank"`
+		Headline string  `db:"headline"`
+	}
+	err = r.db.SelectContext(ctx, &hits, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search articles: %w", err)
 	}
 
 	// Convert to domain models
-	articles := make([]*domain.Article, len(articleDBs))
-	for i, articleDB := range articleDBs {
-		articles[i] = r.dbToArticle(&articleDB)
+	articles := make([]*domain.Article, len(hits))
+	for i := range hits {
+		articles[i] = r.dbToArticle(&hits[i].articleDB)
+		articles[i].SearchRank = hits[i].Rank
+		articles[i].SearchHeadline = headlineToHTML(hits[i].Headline)
 	}
 
 	// Calculate total pages
@@ -301,30 +528,41 @@ func (r *articlePostgresRepository) Search(ctx context.Context, params domain.Se
 	}, nil
 }
 
-func (r *articlePostgresRepository) Create(ctx context.Context, article *domain.Article) error {
+// Create inserts the article and records its first revision, and the
+// transition when it starts out published or scheduled, in one transaction
+func (r *articlePostgresRepository) Create(ctx context.Context, article *domain.Article, history domain.ArticleHistory) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	article.Slug, err = uniqueSlug(ctx, tx, domain.SlugContentArticle, article.Slug, article.ID)
+	if err != nil {
+		return err
+	}
+
 	// Insert article
 	query := `
 		INSERT INTO articles (
-			id, title, excerpt, content, thumbnail, category_id, published_at, updated_at,
-			read_time, slug, featured, published, author_id, view_count, like_count
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+			id, title, excerpt, content, content_text, language, thumbnail, category_id, published_at, updated_at,
+			content_updated_at, read_time, slug, featured, published, status, publish_at, unpublish_at, author_id, view_count, like_count
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
 
 	content := sql.NullString{String: article.Content, Valid: article.Content != ""}
 	thumbnail := sql.NullString{String: article.Thumbnail, Valid: article.Thumbnail != ""}
 
 	_, err = tx.ExecContext(ctx, query,
-		article.ID, article.Title, article.Excerpt, content, thumbnail,
+		article.ID, article.Title, article.Excerpt, content, article.ContentText, article.Language, thumbnail,
 		article.Category.ID, article.PublishedAt, article.UpdatedAt,
-		article.ReadTime, article.Slug, article.Featured, article.Published,
-		article.Author.ID, 0, 0, // view_count and like_count start at 0
+		article.ReadTime, article.Slug, article.Featured, article.Published, article.Status,
+		article.PublishAt, article.UnpublishAt, article.Author.ID, 0, 0, // view_count and like_count start at 0
 	)
 	if err != nil {
+		// Another save took the slug between the check and the insert
+		if isUniqueViolation(err) {
+			return domain.ErrSlugAlreadyExists
+		}
 		return fmt.Errorf("failed to insert article: %w", err)
 	}
 
@@ -336,35 +574,71 @@ func (r *articlePostgresRepository) Create(ctx context.Context, article *domain.
 		}
 	}
 
+	if err := recordArticleHistory(ctx, tx, article.ID, history); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
-func (r *articlePostgresRepository) Update(ctx context.Context, id string, updates domain.UpdateArticleRequest) error {
+// Update applies updates and records history in one transaction, so a
+// failed save leaves neither a half-written article nor a stray revision
+func (r *articlePostgresRepository) Update(ctx context.Context, id string, updates domain.UpdateArticleRequest, history domain.ArticleHistory) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Concurrent saves of one article queue here, so each revision gets the
+	// next number
+	if err := lockArticle(ctx, tx, id); err != nil {
+		return err
+	}
+
 	// Build dynamic update query
 	setParts := []string{}
 	args := []interface{}{}
 	argIndex := 1
 
+	// contentChanges compares the content columns being set with their
+	// stored values, so content_updated_at only moves on a real edit
+	contentChanges := []string{}
+
 	if updates.Title != "" {
 		setParts = append(setParts, fmt.Sprintf("title = $%d", argIndex))
+		contentChanges = append(contentChanges, fmt.Sprintf("title IS DISTINCT FROM $%d", argIndex))
 		args = append(args, updates.Title)
 		argIndex++
 	}
 
 	if updates.Excerpt != "" {
 		setParts = append(setParts, fmt.Sprintf("excerpt = $%d", argIndex))
+		contentChanges = append(contentChanges, fmt.Sprintf("excerpt IS DISTINCT FROM $%d", argIndex))
 		args = append(args, updates.Excerpt)
 		argIndex++
 	}
 
 	if updates.Content != "" {
 		setParts = append(setParts, fmt.Sprintf("content = $%d", argIndex))
+		contentChanges = append(contentChanges, fmt.Sprintf("content IS DISTINCT FROM $%d", argIndex))
 		args = append(args, updates.Content)
 		argIndex++
+
+		setParts = append(setParts, fmt.Sprintf("content_text = $%d", argIndex))
+		args = append(args, updates.ContentText)
+		argIndex++
+	}
+
+	if updates.Language != "" {
+		setParts = append(setParts, fmt.Sprintf("language = $%d", argIndex))
+		args = append(args, updates.Language)
+		argIndex++
 	}
 
 	if updates.Thumbnail != "" {
 		setParts = append(setParts, fmt.Sprintf("thumbnail = $%d", argIndex))
+		contentChanges = append(contentChanges, fmt.Sprintf("thumbnail IS DISTINCT FROM $%d", argIndex))
 		args = append(args, updates.Thumbnail)
 		argIndex++
 	}
@@ -376,8 +650,12 @@ func (r *articlePostgresRepository) Update(ctx context.Context, id string, updat
 	}
 
 	if updates.Slug != "" {
+		slug, err := uniqueSlug(ctx, tx, domain.SlugContentArticle, updates.Slug, id)
+		if err != nil {
+			return err
+		}
 		setParts = append(setParts, fmt.Sprintf("slug = $%d", argIndex))
-		args = append(args, updates.Slug)
+		args = append(args, slug)
 		argIndex++
 	}
 
@@ -399,29 +677,59 @@ func (r *articlePostgresRepository) Update(ctx context.Context, id string, updat
 		argIndex++
 	}
 
-	if len(setParts) == 0 {
-		return nil // Nothing to update
-	}
+	if updates.Status != "" {
+		setParts = append(setParts, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, updates.Status)
+		argIndex++
 
-	// Always update updated_at
-	setParts = append(setParts, fmt.Sprintf("updated_at = $%d", argIndex))
-	args = append(args, time.Now())
-	argIndex++
+		// Going live dates the article, unless it was already live. Any
+		// other move drops a pending publish_at, so an old schedule cannot
+		// publish the article once it is approved again.
+		if updates.Status == domain.ArticleStatusPublished {
+			setParts = append(setParts, "published_at = CASE WHEN published THEN published_at ELSE NOW() END")
+		}
+		if updates.PublishAt == nil && !updates.ClearSchedule {
+			setParts = append(setParts, "publish_at = NULL")
+		}
+	}
 
-	// Add WHERE clause
-	args = append(args, id)
+	if updates.ClearSchedule {
+		setParts = append(setParts, "publish_at = NULL", "unpublish_at = NULL")
+	}
 
-	query := fmt.Sprintf("UPDATE articles SET %s WHERE id = $%d", strings.Join(setParts, ", "), argIndex)
+	if updates.PublishAt != nil {
+		setParts = append(setParts, fmt.Sprintf("publish_at = $%d", argIndex))
+		args = append(args, *updates.PublishAt)
+		argIndex++
+	}
 
-	tx, err := r.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+	if updates.UnpublishAt != nil {
+		setParts = append(setParts, fmt.Sprintf("unpublish_at = $%d", argIndex))
+		args = append(args, *updates.UnpublishAt)
+		argIndex++
 	}
-	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("failed to update article: %w", err)
+	if len(setParts) > 0 {
+		// Always update updated_at
+		setParts = append(setParts, fmt.Sprintf("updated_at = $%d", argIndex))
+		args = append(args, time.Now())
+		if len(contentChanges) > 0 {
+			setParts = append(setParts, fmt.Sprintf("content_updated_at = CASE WHEN %s THEN $%d ELSE content_updated_at END",
+				strings.Join(contentChanges, " OR "), argIndex))
+		}
+		argIndex++
+
+		// Add WHERE clause
+		args = append(args, id)
+
+		query := fmt.Sprintf("UPDATE articles SET %s WHERE id = $%d", strings.Join(setParts, ", "), argIndex)
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.ErrSlugAlreadyExists
+			}
+			return fmt.Errorf("failed to update article: %w", err)
+		}
 	}
 
 	// Update tags if provided
@@ -441,9 +749,31 @@ func (r *articlePostgresRepository) Update(ctx context.Context, id string, updat
 		}
 	}
 
+	if err := recordArticleHistory(ctx, tx, id, history); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
+// recordArticleHistory writes the transition and revision a save records
+// about itself, inside the save's transaction
+func recordArticleHistory(ctx context.Context, tx *sqlx.Tx, articleID string, history domain.ArticleHistory) error {
+	if history.Transition != nil {
+		if err := recordArticleTransition(ctx, tx, history.Transition); err != nil {
+			return err
+		}
+	}
+
+	if history.RevisionID != "" {
+		if err := snapshotArticleRevision(ctx, tx, history.RevisionID, articleID, history.AuthorID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *articlePostgresRepository) Delete(ctx context.Context, id string) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -466,20 +796,181 @@ func (r *articlePostgresRepository) Delete(ctx context.Context, id string) error
 	return tx.Commit()
 }
 
-func (r *articlePostgresRepository) IncrementViewCount(ctx context.Context, id string) error {
-	_, err := r.db.ExecContext(ctx, "UPDATE articles SET view_count = view_count + 1 WHERE id = $1", id)
-	return err
+// view_count and like_count are derived from article_views and article_likes
+// on top of the totals counted before visitors were tracked.
+const (
+	syncViewCount = `UPDATE articles SET view_count = legacy_view_count +
+		(SELECT COUNT(*) FROM article_views WHERE article_id = $1) WHERE id = $1`
+	syncLikeCount = `UPDATE articles SET like_count = legacy_like_count +
+		(SELECT COUNT(*) FROM article_likes WHERE article_id = $1) WHERE id = $1 RETURNING like_count`
+)
+
+func (r *articlePostgresRepository) RecordView(ctx context.Context, id string, visitor domain.Visitor, window time.Duration) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	userID := sql.NullString{String: visitor.UserID, Valid: visitor.UserID != ""}
+	since := time.Now().Add(-window)
+
+	referrer := domain.ReferrerDomain(visitor.Referrer)
+
+	network := sql.NullString{String: visitor.NetworkKey(), Valid: visitor.NetworkKey() != ""}
+
+	// Views beyond MaxVisitorsPerNetwork from one address within the window
+	// are not counted
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO article_views (article_id, visitor_key, user_id, referrer_domain, network_key, viewed_at)
+		SELECT $1, $2, $3, $5, $6, NOW()
+		WHERE EXISTS (SELECT 1 FROM articles WHERE id = $1)
+		  AND NOT EXISTS (
+			SELECT 1 FROM article_views
+			WHERE article_id = $1 AND visitor_key = $2 AND viewed_at > $4
+		  )
+		  AND ($6::varchar IS NULL OR (
+			SELECT COUNT(DISTINCT visitor_key) FROM article_views
+			WHERE article_id = $1 AND network_key = $6 AND viewed_at > $4
+		  ) < $7)`,
+		id, visitor.Key(), userID, since, referrer, network, domain.MaxVisitorsPerNetwork)
+	if err != nil {
+		return false, fmt.Errorf("failed to record article view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, syncViewCount, id); err != nil {
+		return false, fmt.Errorf("failed to update view count: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO article_daily_stats (article_id, day, views)
+		VALUES ($1, CURRENT_DATE, 1)
+		ON CONFLICT (article_id, day) DO UPDATE SET views = article_daily_stats.views + 1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to update daily views: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO article_daily_referrers (article_id, day, domain, views)
+		VALUES ($1, CURRENT_DATE, $2, 1)
+		ON CONFLICT (article_id, day, domain) DO UPDATE SET views = article_daily_referrers.views + 1`,
+		id, referrer)
+	if err != nil {
+		return false, fmt.Errorf("failed to update daily referrers: %w", err)
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *articlePostgresRepository) ToggleLike(ctx context.Context, id string, visitor domain.Visitor) (*domain.LikeState, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the article so concurrent toggles from one visitor serialize, and
+	// take today's date from the database like the rest of the rollup
+	var locked struct {
+		ID    string    `db:"id"`
+		Today time.Time `db:"today"`
+	}
+	err = tx.GetContext(ctx, &locked,
+		"SELECT id, CURRENT_DATE AS today FROM articles WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrArticleNotFound
+		}
+		return nil, fmt.Errorf("failed to get article: %w", err)
+	}
+
+	var likedOn time.Time
+	err = tx.GetContext(ctx, &likedOn, `
+		DELETE FROM article_likes WHERE article_id = $1 AND visitor_key = $2
+		RETURNING created_at::date`, id, visitor.Key())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to remove article like: %w", err)
+	}
+
+	state := &domain.LikeState{Liked: err == sql.ErrNoRows}
+	if state.Liked {
+		network := sql.NullString{String: visitor.NetworkKey(), Valid: visitor.NetworkKey() != ""}
+		if network.Valid {
+			var likes int
+			err = tx.GetContext(ctx, &likes,
+				"SELECT COUNT(*) FROM article_likes WHERE article_id = $1 AND network_key = $2", id, network)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count article likes: %w", err)
+			}
+			if likes >= domain.MaxVisitorsPerNetwork {
+				return nil, domain.ErrTooManyVisitors
+			}
+		}
+
+		userID := sql.NullString{String: visitor.UserID, Valid: visitor.UserID != ""}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO article_likes (article_id, visitor_key, user_id, network_key, created_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			ON CONFLICT DO NOTHING`,
+			id, visitor.Key(), userID, network)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add article like: %w", err)
+		}
+	}
+
+	// The daily rollup keeps net likes per day the likes were made
+	day, delta := state.LikeRollup(likedOn, locked.Today)
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO article_daily_stats (article_id, day, likes)
+		VALUES ($1, $2::date, $3)
+		ON CONFLICT (article_id, day) DO UPDATE SET likes = article_daily_stats.likes + $3`,
+		id, day.Format("2006-01-02"), delta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update daily likes: %w", err)
+	}
+
+	err = tx.GetContext(ctx, &state.Likes, syncLikeCount, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update like count: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit like: %w", err)
+	}
+
+	return state, nil
 }
 
-func (r *articlePostgresRepository) IncrementLikeCount(ctx context.Context, id string) (int, error) {
-	var newCount int
-	err := r.db.GetContext(ctx, &newCount,
-		"UPDATE articles SET like_count = like_count + 1 WHERE id = $1 RETURNING like_count", id)
-	return newCount, err
+func (r *articlePostgresRepository) GetLikeState(ctx context.Context, id string, visitor domain.Visitor) (*domain.LikeState, error) {
+	var state domain.LikeState
+	err := r.db.QueryRowxContext(ctx, `
+		SELECT a.like_count,
+			EXISTS (SELECT 1 FROM article_likes l WHERE l.article_id = a.id AND l.visitor_key = $2)
+		FROM articles a WHERE a.id = $1`,
+		id, visitor.Key()).Scan(&state.Likes, &state.Liked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrArticleNotFound
+		}
+		return nil, fmt.Errorf("failed to get like state: %w", err)
+	}
+
+	return &state, nil
 }
 
 func (r *articlePostgresRepository) GetStats(ctx context.Context, id string) (*domain.ArticleStats, error) {
-	query := "SELECT view_count, like_count FROM articles WHERE id = $1"
+	query := `
+		SELECT view_count AS views, like_count AS likes,
+			(SELECT COUNT(*) FROM article_comments WHERE article_id = a.id AND status = 'approved') AS comments
+		FROM articles a WHERE a.id = $1`
 
 	var stats domain.ArticleStats
 	err := r.db.GetContext(ctx, &stats, query, id)
@@ -491,12 +982,44 @@ func (r *articlePostgresRepository) GetStats(ctx context.Context, id string) (*d
 	}
 
 	stats.ArticleID = id
-	// TODO: Get comments count from comments table
-	stats.Comments = 0
 
 	return &stats, nil
 }
 
+// ApplyPublishSchedule publishes articles whose publish_at has passed and
+// unpublishes those whose unpublish_at has passed.
+func (r *articlePostgresRepository) ApplyPublishSchedule(ctx context.Context, now time.Time) (int, int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Published articles with a past publish_at are being backdated
+	result, err := tx.ExecContext(ctx, `
+		UPDATE articles SET published = true, status = 'published', published_at = publish_at, publish_at = NULL
+		WHERE publish_at IS NOT NULL AND publish_at <= $1 AND status IN ('approved', 'published')`, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to publish scheduled articles: %w", err)
+	}
+	published, _ := result.RowsAffected()
+
+	result, err = tx.ExecContext(ctx, `
+		UPDATE articles SET published = false, unpublish_at = NULL,
+			status = CASE WHEN status = 'published' THEN 'archived' ELSE status END
+		WHERE unpublish_at IS NOT NULL AND unpublish_at <= $1`, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to unpublish expired articles: %w", err)
+	}
+	unpublished, _ := result.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+
+	return int(published), int(unpublished), nil
+}
+
 // Helper methods
 
 func (r *articlePostgresRepository) dbToArticle(articleDB *articleDB) *domain.Article {
@@ -525,6 +1048,7 @@ func (r *articlePostgresRepository) dbToArticle(articleDB *articleDB) *domain.Ar
 		Title:     articleDB.Title,
 		Excerpt:   articleDB.Excerpt,
 		Content:   content,
+		Language:  articleDB.Language,
 		Thumbnail: thumbnail,
 		Category: domain.Category{
 			ID:      articleDB.CategoryID,
@@ -533,23 +1057,36 @@ func (r *articlePostgresRepository) dbToArticle(articleDB *articleDB) *domain.Ar
 			BgColor: articleDB.CategoryBgColor,
 			Slug:    articleDB.CategorySlug,
 		},
-		PublishedAt: articleDB.PublishedAt,
-		UpdatedAt:   articleDB.UpdatedAt,
-		ReadTime:    articleDB.ReadTime,
-		Slug:        articleDB.Slug,
-		Featured:    articleDB.Featured,
-		Published:   articleDB.Published,
+		PublishedAt:      articleDB.PublishedAt,
+		UpdatedAt:        articleDB.UpdatedAt,
+		ContentUpdatedAt: articleDB.ContentUpdated,
+		ReadTime:         articleDB.ReadTime,
+		Slug:             articleDB.Slug,
+		Featured:         articleDB.Featured,
+		Published:        articleDB.Published,
+		Status:           articleDB.Status,
+		PublishAt:        articleDB.PublishAt,
+		UnpublishAt:      articleDB.UnpublishAt,
 		Author: domain.Author{
 			ID:     articleDB.AuthorID,
 			Name:   articleDB.AuthorName,
 			Email:  authorEmail,
 			Avatar: authorAvatar,
+			Bio:    articleDB.AuthorBio.String,
+			Slug:   articleDB.AuthorSlug.String,
 		},
 		ViewCount: articleDB.ViewCount,
 		LikeCount: articleDB.LikeCount,
 	}
 }
 
+// headlineToHTML escapes a ts_headline snippet and turns its markers into <mark> tags
+func headlineToHTML(headline string) string {
+	escaped := html.EscapeString(headline)
+	escaped = strings.ReplaceAll(escaped, headlineStart, "<mark>")
+	return strings.ReplaceAll(escaped, headlineStop, "</mark>")
+}
+
 func (r *articlePostgresRepository) getArticleTags(ctx context.Context, articleID string) ([]string, error) {
 	query := "SELECT name FROM article_tags WHERE article_id = $1 ORDER BY name"
 
@@ -562,15 +1099,32 @@ func (r *articlePostgresRepository) getArticleTags(ctx context.Context, articleI
 	return tags, nil
 }
 
+// insertArticleTags links an article to the canonical tag for each name,
+// creating tags on first use. Names that share a slug with an existing tag
+// are stored under that tag's name.
 func (r *articlePostgresRepository) insertArticleTags(ctx context.Context, tx *sqlx.Tx, articleID string, tags []string) error {
 	for _, tag := range tags {
-		if strings.TrimSpace(tag) == "" {
-			continue
+		name := strings.TrimSpace(tag)
+		slug := domain.Slugify(name)
+		if slug == "" {
+			return domain.ErrInvalidTagName
+		}
+
+		var tagID string
+		err := tx.GetContext(ctx, &tagID, `
+			INSERT INTO tags (name, slug) VALUES ($1, $2)
+			ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+			RETURNING id`,
+			name, slug)
+		if err != nil {
+			return err
 		}
 
-		_, err := tx.ExecContext(ctx,
-			"INSERT INTO article_tags (article_id, name) VALUES ($1, $2)",
-			articleID, strings.TrimSpace(tag))
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO article_tags (article_id, tag_id, name)
+			SELECT $1, t.id, t.name FROM tags t WHERE t.id = $2
+			ON CONFLICT DO NOTHING`,
+			articleID, tagID)
 		if err != nil {
 			return err
 		}
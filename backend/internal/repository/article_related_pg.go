@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type articleRelationPostgresRepository struct {
+	db       *sqlx.DB
+	articles *articlePostgresRepository
+}
+
+func NewArticleRelationPostgresRepository(db *sqlx.DB) domain.ArticleRelationRepository {
+	return &articleRelationPostgresRepository{
+		db:       db,
+		articles: &articlePostgresRepository{db: db},
+	}
+}
+
+func (r *articleRelationPostgresRepository) GetCandidates(ctx context.Context) ([]*domain.Article, error) {
+	query := `
+		SELECT ` + articleColumns + `, a.content_text` + articleFrom + `
+		WHERE ` + articleVisible
+
+	var rows []struct {
+		articleDB
+		ContentText string `db:"content_text"`
+	}
+	err := r.db.SelectContext(ctx, &rows, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related candidates: %w", err)
+	}
+
+	// Load tags for all candidates in one query
+	var tagRows []struct {
+		ArticleID string `db:"article_id"`
+		Name      string `db:"name"`
+	}
+	err = r.db.SelectContext(ctx, &tagRows, `
+		SELECT t.article_id, t.name
+		FROM article_tags t
+		INNER JOIN articles a ON a.id = t.article_id
+		WHERE `+articleVisible+`
+		ORDER BY t.name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related candidate tags: %w", err)
+	}
+
+	tags := make(map[string][]string)
+	for _, row := range tagRows {
+		tags[row.ArticleID] = append(tags[row.ArticleID], row.Name)
+	}
+
+	articles := make([]*domain.Article, len(rows))
+	for i := range rows {
+		articles[i] = r.articles.dbToArticle(&rows[i].articleDB)
+		articles[i].ContentText = rows[i].ContentText
+		articles[i].Tags = tags[rows[i].ID]
+	}
+
+	return articles, nil
+}
+
+func (r *articleRelationPostgresRepository) GetPinned(ctx context.Context, articleID string) ([]*domain.Article, error) {
+	query := articleSelect + `
+		INNER JOIN article_related_pins p ON p.related_article_id = a.id
+		WHERE p.article_id = $1 AND ` + articleVisible + `
+		ORDER BY p.position`
+
+	var rows []articleDB
+	err := r.db.SelectContext(ctx, &rows, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned related articles: %w", err)
+	}
+
+	articles := make([]*domain.Article, len(rows))
+	for i := range rows {
+		articles[i] = r.articles.dbToArticle(&rows[i])
+	}
+
+	return articles, nil
+}
+
+func (r *articleRelationPostgresRepository) GetPinnedIDs(ctx context.Context, articleID string) ([]string, error) {
+	ids := []string{}
+	err := r.db.SelectContext(ctx, &ids,
+		"SELECT related_article_id FROM article_related_pins WHERE article_id = $1 ORDER BY position",
+		articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned related article IDs: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *articleRelationPostgresRepository) SetPinned(ctx context.Context, articleID string, relatedIDs []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM article_related_pins WHERE article_id = $1", articleID)
+	if err != nil {
+		return fmt.Errorf("failed to clear pinned related articles: %w", err)
+	}
+
+	if len(relatedIDs) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO article_related_pins (article_id, related_article_id, position)
+			SELECT $1, related.id, related.position
+			FROM unnest($2::varchar[]) WITH ORDINALITY AS related(id, position)`,
+			articleID, pq.Array(relatedIDs))
+		if err != nil {
+			return fmt.Errorf("failed to pin related articles: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type articleRevisionPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewArticleRevisionPostgresRepository(db *sqlx.DB) domain.ArticleRevisionRepository {
+	return &articleRevisionPostgresRepository{
+		db: db,
+	}
+}
+
+// Article revision database model
+type articleRevisionDB struct {
+	ID             string         `db:"id"`
+	ArticleID      string         `db:"article_id"`
+	RevisionNumber int            `db:"revision_number"`
+	Title          string         `db:"title"`
+	Excerpt        string         `db:"excerpt"`
+	Content        sql.NullString `db:"content"`
+	CategoryID     string         `db:"category_id"`
+	Tags           []byte         `db:"tags"`
+	AuthorID       sql.NullString `db:"author_id"`
+	AuthorName     sql.NullString `db:"author_name"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+func (r *articleRevisionPostgresRepository) Create(ctx context.Context, revision *domain.ArticleRevision) error {
+	tagsJSON, err := json.Marshal(revision.Tags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal revision tags: %w", err)
+	}
+
+	authorID := sql.NullString{String: revision.AuthorID, Valid: revision.AuthorID != ""}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Revision numbers are sequential per article; holding the article row
+	// keeps two saves from taking the same number
+	if err := lockArticle(ctx, tx, revision.ArticleID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO article_revisions (
+			id, article_id, revision_number, title, excerpt, content, category_id, tags, author_id, created_at
+		)
+		SELECT $1, $2, COALESCE(MAX(revision_number), 0) + 1, $3, $4, $5, $6, $7::jsonb, $8, $9
+		FROM article_revisions WHERE article_id = $2
+		RETURNING revision_number`
+
+	err = tx.GetContext(ctx, &revision.RevisionNumber, query,
+		revision.ID, revision.ArticleID, revision.Title, revision.Excerpt, revision.Content,
+		revision.CategoryID, tagsJSON, authorID, revision.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create article revision: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// lockArticle holds the article row until tx ends
+func lockArticle(ctx context.Context, tx *sqlx.Tx, articleID string) error {
+	var id string
+	err := tx.GetContext(ctx, &id, `SELECT id FROM articles WHERE id = $1 FOR UPDATE`, articleID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrArticleNotFound
+		}
+		return fmt.Errorf("failed to lock article: %w", err)
+	}
+	return nil
+}
+
+// snapshotArticleRevision stores the article as it stands in tx, tags
+// included, as its next revision. The caller must hold the article row,
+// see lockArticle.
+func snapshotArticleRevision(ctx context.Context, tx *sqlx.Tx, id, articleID, authorID string) error {
+	query := `
+		INSERT INTO article_revisions (
+			id, article_id, revision_number, title, excerpt, content, category_id, tags, author_id, created_at
+		)
+		SELECT $1, a.id,
+			(SELECT COALESCE(MAX(revision_number), 0) + 1 FROM article_revisions WHERE article_id = a.id),
+			a.title, a.excerpt, a.content, a.category_id,
+			COALESCE((SELECT jsonb_agg(t.name ORDER BY t.name) FROM article_tags t WHERE t.article_id = a.id), '[]'::jsonb),
+			$3, NOW()
+		FROM articles a
+		WHERE a.id = $2`
+
+	authorIDArg := sql.NullString{String: authorID, Valid: authorID != ""}
+	if _, err := tx.ExecContext(ctx, query, id, articleID, authorIDArg); err != nil {
+		return fmt.Errorf("failed to record article revision: %w", err)
+	}
+
+	return nil
+}
+
+func (r *articleRevisionPostgresRepository) GetByArticle(ctx context.Context, articleID string) ([]*domain.ArticleRevision, error) {
+	query := `
+		SELECT 
+			ar.id, ar.article_id, ar.revision_number, ar.title, ar.excerpt, ar.category_id,
+			ar.tags, ar.author_id, u.name as author_name, ar.created_at
+		FROM article_revisions ar
+		LEFT JOIN users u ON ar.author_id = u.id
+		WHERE ar.article_id = $1
+		ORDER BY ar.revision_number DESC`
+
+	var revisionDBs []articleRevisionDB
+	err := r.db.SelectContext(ctx, &revisionDBs, query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article revisions: %w", err)
+	}
+
+	revisions := make([]*domain.ArticleRevision, len(revisionDBs))
+	for i := range revisionDBs {
+		revisions[i] = r.dbToRevision(&revisionDBs[i])
+	}
+
+	return revisions, nil
+}
+
+func (r *articleRevisionPostgresRepository) GetByID(ctx context.Context, articleID, id string) (*domain.ArticleRevision, error) {
+	query := `
+		SELECT 
+			ar.id, ar.article_id, ar.revision_number, ar.title, ar.excerpt, ar.content, ar.category_id,
+			ar.tags, ar.author_id, u.name as author_name, ar.created_at
+		FROM article_revisions ar
+		LEFT JOIN users u ON ar.author_id = u.id
+		WHERE ar.article_id = $1 AND ar.id = $2`
+
+	var revisionDB articleRevisionDB
+	err := r.db.GetContext(ctx, &revisionDB, query, articleID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get article revision: %w", err)
+	}
+
+	return r.dbToRevision(&revisionDB), nil
+}
+
+func (r *articleRevisionPostgresRepository) dbToRevision(revisionDB *articleRevisionDB) *domain.ArticleRevision {
+	tags := []string{}
+	if len(revisionDB.Tags) > 0 {
+		_ = json.Unmarshal(revisionDB.Tags, &tags)
+	}
+
+	return &domain.ArticleRevision{
+		ID:             revisionDB.ID,
+		ArticleID:      revisionDB.ArticleID,
+		RevisionNumber: revisionDB.RevisionNumber,
+		Title:          revisionDB.Title,
+		Excerpt:        revisionDB.Excerpt,
+		Content:        revisionDB.Content.String,
+		CategoryID:     revisionDB.CategoryID,
+		Tags:           tags,
+		AuthorID:       revisionDB.AuthorID.String,
+		AuthorName:     revisionDB.AuthorName.String,
+		CreatedAt:      revisionDB.CreatedAt,
+	}
+}
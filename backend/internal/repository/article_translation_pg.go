@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type articleTranslationPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewArticleTranslationPostgresRepository(db *sqlx.DB) domain.ArticleTranslationRepository {
+	return &articleTranslationPostgresRepository{
+		db: db,
+	}
+}
+
+const translationSelect = `
+		SELECT t.id, t.article_id, t.language, t.title, t.excerpt, t.content, t.read_time,
+			t.slug, t.published, t.created_at, t.updated_at, a.slug AS article_slug
+		FROM article_translations t
+		INNER JOIN articles a ON a.id = t.article_id`
+
+func (r *articleTranslationPostgresRepository) GetByArticle(ctx context.Context, articleID string) ([]*domain.ArticleTranslation, error) {
+	translations := []*domain.ArticleTranslation{}
+	err := r.db.SelectContext(ctx, &translations, translationSelect+`
+		WHERE t.article_id = $1
+		ORDER BY t.language`, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article translations: %w", err)
+	}
+
+	return translations, nil
+}
+
+func (r *articleTranslationPostgresRepository) Get(ctx context.Context, articleID, lang string) (*domain.ArticleTranslation, error) {
+	return r.getOne(ctx, `t.article_id = $1 AND t.language = $2`, articleID, lang)
+}
+
+func (r *articleTranslationPostgresRepository) GetBySlug(ctx context.Context, slug string) (*domain.ArticleTranslation, error) {
+	return r.getOne(ctx, `t.slug = $1 AND t.published = true`, slug)
+}
+
+func (r *articleTranslationPostgresRepository) getOne(ctx context.Context, where string, args ...interface{}) (*domain.ArticleTranslation, error) {
+	var translation domain.ArticleTranslation
+	err := r.db.GetContext(ctx, &translation, translationSelect+`
+		WHERE `+where, args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrTranslationNotFound
+		}
+		return nil, fmt.Errorf("failed to get article translation: %w", err)
+	}
+
+	return &translation, nil
+}
+
+func (r *articleTranslationPostgresRepository) GetPublished(ctx context.Context, articleIDs []string, lang string) (map[string]*domain.ArticleTranslation, error) {
+	result := make(map[string]*domain.ArticleTranslation)
+	if len(articleIDs) == 0 {
+		return result, nil
+	}
+
+	var translations []*domain.ArticleTranslation
+	err := r.db.SelectContext(ctx, &translations, translationSelect+`
+		WHERE t.article_id = ANY($1) AND t.language = $2 AND t.published = true`,
+		pq.Array(articleIDs), lang)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article translations: %w", err)
+	}
+
+	for _, translation := range translations {
+		result[translation.ArticleID] = translation
+	}
+	return result, nil
+}
+
+func (r *articleTranslationPostgresRepository) Save(ctx context.Context, translation *domain.ArticleTranslation, transition *domain.ArticleTransition) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	slug, err := uniqueTranslationSlug(ctx, tx, translation.Slug, translation.ArticleID, translation.Language)
+	if err != nil {
+		return err
+	}
+	translation.Slug = slug
+
+	query := `
+		INSERT INTO article_translations (id, article_id, language, title, excerpt, content, read_time, slug, published, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		ON CONFLICT (article_id, language) DO UPDATE SET
+			title = EXCLUDED.title,
+			excerpt = EXCLUDED.excerpt,
+			content = EXCLUDED.content,
+			read_time = EXCLUDED.read_time,
+			slug = EXCLUDED.slug,
+			published = EXCLUDED.published,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err = tx.ExecContext(ctx, query,
+		translation.ID, translation.ArticleID, translation.Language, translation.Title, translation.Excerpt,
+		translation.Content, translation.ReadTime, translation.Slug, translation.Published)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrSlugAlreadyExists
+		}
+		return fmt.Errorf("failed to save article translation: %w", err)
+	}
+
+	if err := recordArticleTransition(ctx, tx, transition); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *articleTranslationPostgresRepository) Delete(ctx context.Context, articleID, lang string, transition *domain.ArticleTransition) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM article_translations WHERE article_id = $1 AND language = $2`, articleID, lang)
+	if err != nil {
+		return fmt.Errorf("failed to delete article translation: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete article translation: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrTranslationNotFound
+	}
+
+	if err := recordArticleTransition(ctx, tx, transition); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
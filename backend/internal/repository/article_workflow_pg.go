@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type articleWorkflowPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewArticleWorkflowPostgresRepository(db *sqlx.DB) domain.ArticleWorkflowRepository {
+	return &articleWorkflowPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *articleWorkflowPostgresRepository) Record(ctx context.Context, transition *domain.ArticleTransition) error {
+	return recordArticleTransition(ctx, r.db, transition)
+}
+
+// recordArticleTransition inserts a workflow step, inside the caller's
+// transaction when given one
+func recordArticleTransition(ctx context.Context, exec sqlx.ExecerContext, transition *domain.ArticleTransition) error {
+	actorID := sql.NullString{String: transition.ActorID, Valid: transition.ActorID != ""}
+
+	query := `
+		INSERT INTO article_transitions (id, article_id, action, from_status, to_status, comment, actor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := exec.ExecContext(ctx, query,
+		transition.ID, transition.ArticleID, transition.Action, transition.FromStatus,
+		transition.ToStatus, transition.Comment, actorID, transition.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record article transition: %w", err)
+	}
+
+	return nil
+}
+
+func (r *articleWorkflowPostgresRepository) GetByArticle(ctx context.Context, articleID string) ([]*domain.ArticleTransition, error) {
+	query := `
+		SELECT t.id, t.article_id, t.action, t.from_status, t.to_status, t.comment,
+			COALESCE(t.actor_id::text, '') AS actor_id, COALESCE(u.name, '') AS actor_name, t.created_at
+		FROM article_transitions t
+		LEFT JOIN users u ON u.id = t.actor_id
+		WHERE t.article_id = $1
+		ORDER BY t.created_at, t.id`
+
+	transitions := []*domain.ArticleTransition{}
+	if err := r.db.SelectContext(ctx, &transitions, query, articleID); err != nil {
+		return nil, fmt.Errorf("failed to get article transitions: %w", err)
+	}
+
+	return transitions, nil
+}
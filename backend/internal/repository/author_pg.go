@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type authorPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuthorPostgresRepository(db *sqlx.DB) domain.AuthorRepository {
+	return &authorPostgresRepository{
+		db: db,
+	}
+}
+
+// authorSelect lists admins and profiled users with their published article
+// count; callers append WHERE/ORDER clauses
+const authorSelect = `
+		SELECT u.id::text AS id, u.name, ap.slug, ap.bio, ap.avatar, ap.social_links, ap.updated_at,
+			(SELECT COUNT(*) FROM articles a WHERE a.author_id = u.id AND ` + articleVisible + `) AS article_count
+		FROM users u
+		LEFT JOIN author_profiles ap ON ap.user_id = u.id`
+
+// authorEligible matches users that may author articles
+const authorEligible = `((u.user_type = 'admin' AND u.is_active = true) OR ap.user_id IS NOT NULL)`
+
+type authorDB struct {
+	ID           string         `db:"id"`
+	Name         string         `db:"name"`
+	Slug         sql.NullString `db:"slug"`
+	Bio          sql.NullString `db:"bio"`
+	Avatar       sql.NullString `db:"avatar"`
+	SocialLinks  []byte         `db:"social_links"`
+	UpdatedAt    *time.Time     `db:"updated_at"`
+	ArticleCount int            `db:"article_count"`
+}
+
+func (r *authorPostgresRepository) GetAll(ctx context.Context) ([]*domain.AuthorProfile, error) {
+	query := authorSelect + `
+		WHERE ` + authorEligible + `
+		ORDER BY u.name`
+
+	var rows []authorDB
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+
+	authors := make([]*domain.AuthorProfile, len(rows))
+	for i := range rows {
+		author, err := dbToAuthor(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		authors[i] = author
+	}
+
+	return authors, nil
+}
+
+func (r *authorPostgresRepository) GetByID(ctx context.Context, userID string) (*domain.AuthorProfile, error) {
+	return r.getOne(ctx, `u.id::text = $1 AND `+authorEligible, userID)
+}
+
+func (r *authorPostgresRepository) GetBySlug(ctx context.Context, slug string) (*domain.AuthorProfile, error) {
+	return r.getOne(ctx, `ap.slug = $1`, slug)
+}
+
+func (r *authorPostgresRepository) getOne(ctx context.Context, where string, arg string) (*domain.AuthorProfile, error) {
+	var row authorDB
+	err := r.db.GetContext(ctx, &row, authorSelect+`
+		WHERE `+where, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAuthorNotFound
+		}
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+
+	return dbToAuthor(&row)
+}
+
+func (r *authorPostgresRepository) SaveProfile(ctx context.Context, profile *domain.AuthorProfile) error {
+	if profile.SocialLinks == nil {
+		profile.SocialLinks = map[string]string{}
+	}
+	links, err := json.Marshal(profile.SocialLinks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal social links: %w", err)
+	}
+
+	query := `
+		INSERT INTO author_profiles (user_id, slug, bio, avatar, social_links, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5::jsonb, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			slug = EXCLUDED.slug,
+			bio = EXCLUDED.bio,
+			avatar = EXCLUDED.avatar,
+			social_links = EXCLUDED.social_links,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err = r.db.ExecContext(ctx, query, profile.ID, profile.Slug, profile.Bio, profile.Avatar, links)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrSlugAlreadyExists
+		}
+		return fmt.Errorf("failed to save author profile: %w", err)
+	}
+
+	return nil
+}
+
+func (r *authorPostgresRepository) AssignArticle(ctx context.Context, articleID, authorID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE articles SET author_id = $1, updated_at = NOW()
+		WHERE id = $2`, authorID, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to reassign article: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to reassign article: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrArticleNotFound
+	}
+
+	return nil
+}
+
+func dbToAuthor(row *authorDB) (*domain.AuthorProfile, error) {
+	author := &domain.AuthorProfile{
+		ID:           row.ID,
+		Name:         row.Name,
+		Slug:         row.Slug.String,
+		Bio:          row.Bio.String,
+		Avatar:       row.Avatar.String,
+		SocialLinks:  map[string]string{},
+		ArticleCount: row.ArticleCount,
+		UpdatedAt:    row.UpdatedAt,
+	}
+
+	if len(row.SocialLinks) > 0 {
+		if err := json.Unmarshal(row.SocialLinks, &author.SocialLinks); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal social links: %w", err)
+		}
+	}
+
+	return author, nil
+}
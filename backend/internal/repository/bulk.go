@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio/internal/domain/bulk"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// runBulk applies fn to each ID inside one transaction. Every item runs in
+// a savepoint, so a failing item is undone on its own and the remaining
+// items are still checked for the report; the batch is committed only when
+// no item failed. fn reports whether it changed the item.
+func runBulk(ctx context.Context, db *sqlx.DB, action string, ids []string, fn func(tx *sqlx.Tx, id string) (bool, error)) (*bulk.Report, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	report := &bulk.Report{Action: action, Items: make([]bulk.ItemResult, 0, len(ids))}
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT bulk_item"); err != nil {
+			return nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		changed, itemErr := fn(tx, id)
+		if itemErr != nil {
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bulk_item"); err != nil {
+				return nil, fmt.Errorf("failed to roll back item: %w", err)
+			}
+		} else if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT bulk_item"); err != nil {
+			return nil, fmt.Errorf("failed to release savepoint: %w", err)
+		}
+
+		report.Add(id, changed, itemErr)
+	}
+
+	if report.Failed > 0 {
+		return report, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit batch: %w", err)
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// affected reports whether an UPDATE or DELETE touched a row
+func affected(ctx context.Context, tx *sqlx.Tx, query string, args ...interface{}) (bool, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
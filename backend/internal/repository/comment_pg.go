@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type commentPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewCommentPostgresRepository(db *sqlx.DB) domain.CommentRepository {
+	return &commentPostgresRepository{
+		db: db,
+	}
+}
+
+// Comment database model
+type commentDB struct {
+	ID           string         `db:"id"`
+	ArticleID    string         `db:"article_id"`
+	ArticleTitle string         `db:"article_title"`
+	ParentID     sql.NullString `db:"parent_id"`
+	UserID       string         `db:"user_id"`
+	AuthorName   string         `db:"author_name"`
+	Body         string         `db:"body"`
+	Status       string         `db:"status"`
+	SpamScore    int            `db:"spam_score"`
+	SpamReasons  pq.StringArray `db:"spam_reasons"`
+	ModeratedBy  sql.NullString `db:"moderated_by"`
+	ModeratedAt  *time.Time     `db:"moderated_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+const commentSelect = `
+		SELECT cm.id, cm.article_id, a.title AS article_title, cm.parent_id, cm.user_id,
+			u.name AS author_name, cm.body, cm.status, cm.spam_score, cm.spam_reasons,
+			cm.moderated_by, cm.moderated_at, cm.created_at
+		FROM article_comments cm
+		INNER JOIN articles a ON a.id = cm.article_id
+		INNER JOIN users u ON u.id = cm.user_id`
+
+func (r *commentPostgresRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	parentID := sql.NullString{String: comment.ParentID, Valid: comment.ParentID != ""}
+	spamReasons := pq.StringArray(comment.SpamReasons)
+	if spamReasons == nil {
+		spamReasons = pq.StringArray{} // a nil array would be stored as NULL
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO article_comments (id, article_id, parent_id, user_id, body, status, spam_score, spam_reasons, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		comment.ID, comment.ArticleID, parentID, comment.UserID, comment.Body, comment.Status,
+		comment.SpamScore, spamReasons, comment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *commentPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	var row commentDB
+	err := r.db.GetContext(ctx, &row, commentSelect+` WHERE cm.id = $1`, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return r.dbToComment(&row), nil
+}
+
+func (r *commentPostgresRepository) GetApprovedByArticle(ctx context.Context, articleID string) ([]*domain.Comment, error) {
+	query := commentSelect + `
+		WHERE cm.article_id = $1 AND cm.status = 'approved'
+		ORDER BY cm.created_at ASC`
+
+	return r.selectComments(ctx, query, articleID)
+}
+
+func (r *commentPostgresRepository) List(ctx context.Context, params domain.CommentListParams) (*domain.CommentListResult, error) {
+	var conditions []string
+	var args []interface{}
+	argIndex := 1
+
+	if params.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("cm.status = $%d", argIndex))
+		args = append(args, params.Status)
+		argIndex++
+	}
+
+	if params.ArticleID != "" {
+		conditions = append(conditions, fmt.Sprintf("cm.article_id = $%d", argIndex))
+		args = append(args, params.ArticleID)
+		argIndex++
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	err := r.db.GetContext(ctx, &total, "SELECT COUNT(*) FROM article_comments cm "+whereClause, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	// Oldest first so the moderation queue is worked in arrival order
+	query := fmt.Sprintf(commentSelect+`
+		%s
+		ORDER BY cm.created_at ASC
+		LIMIT $%d OFFSET $%d`, whereClause, argIndex, argIndex+1)
+	args = append(args, params.Limit, (params.Page-1)*params.Limit)
+
+	comments, err := r.selectComments(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CommentListResult{
+		Comments:   comments,
+		Total:      total,
+		Page:       params.Page,
+		TotalPages: (total + params.Limit - 1) / params.Limit,
+	}, nil
+}
+
+func (r *commentPostgresRepository) GetRecentByUser(ctx context.Context, userID string, since time.Time) ([]*domain.Comment, error) {
+	query := commentSelect + `
+		WHERE cm.user_id = $1 AND cm.created_at >= $2
+		ORDER BY cm.created_at DESC`
+
+	return r.selectComments(ctx, query, userID, since)
+}
+
+func (r *commentPostgresRepository) UpdateStatus(ctx context.Context, id, status, moderatorID string) error {
+	moderatedBy := sql.NullString{String: moderatorID, Valid: moderatorID != ""}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE article_comments SET status = $1, moderated_by = $2, moderated_at = NOW(), updated_at = NOW()
+		WHERE id = $3`,
+		status, moderatedBy, id)
+	if err != nil {
+		return fmt.Errorf("failed to update comment status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *commentPostgresRepository) Delete(ctx context.Context, id string) error {
+	// Replies are removed with their parent
+	result, err := r.db.ExecContext(ctx, "DELETE FROM article_comments WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *commentPostgresRepository) selectComments(ctx context.Context, query string, args ...interface{}) ([]*domain.Comment, error) {
+	var rows []commentDB
+	err := r.db.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	comments := make([]*domain.Comment, len(rows))
+	for i := range rows {
+		comments[i] = r.dbToComment(&rows[i])
+	}
+
+	return comments, nil
+}
+
+func (r *commentPostgresRepository) dbToComment(row *commentDB) *domain.Comment {
+	return &domain.Comment{
+		ID:           row.ID,
+		ArticleID:    row.ArticleID,
+		ArticleTitle: row.ArticleTitle,
+		ParentID:     row.ParentID.String,
+		UserID:       row.UserID,
+		AuthorName:   row.AuthorName,
+		Body:         row.Body,
+		Status:       row.Status,
+		SpamScore:    row.SpamScore,
+		SpamReasons:  row.SpamReasons,
+		ModeratedBy:  row.ModeratedBy.String,
+		ModeratedAt:  row.ModeratedAt,
+		CreatedAt:    row.CreatedAt,
+	}
+}
@@ -10,9 +10,17 @@ import (
 	"github.com/gosimple/slug"
 	"github.com/jmoiron/sqlx"
 
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
 	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/pagination"
 )
 
+// courseVisible matches courses the public may see. A scheduled course stays
+// a draft until the scheduler publishes it; unpublish_at is honoured
+// directly so a take-down holds even before the scheduler has run.
+const courseVisible = `c.status = 'published' AND COALESCE(c.unpublish_at > NOW(), true)`
+
 type coursePgRepository struct {
 	db *sqlx.DB
 }
@@ -24,25 +32,40 @@ func NewCoursePgRepository(db *sqlx.DB) course.Repository {
 // CreateCourse creates a new course
 func (r *coursePgRepository) CreateCourse(ctx context.Context, c *course.Course) error {
 	c.ID = uuid.New().String()
-	c.Slug = slug.Make(c.Title)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	uniqueCourseSlug, err := uniqueSlug(ctx, tx, domain.SlugContentCourse, slug.Make(c.Title), c.ID)
+	if err != nil {
+		return err
+	}
+	c.Slug = uniqueCourseSlug
 	c.CreatedAt = time.Now()
 	c.UpdatedAt = time.Now()
 
 	query := `
-		INSERT INTO courses (id, title, slug, description, thumbnail, price, is_free, level, status, instructor_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO courses (id, title, slug, description, thumbnail, price, is_free, level, status, instructor_id, publish_at, unpublish_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		c.ID, c.Title, c.Slug, c.Description, c.Thumbnail, c.Price,
-		c.IsFree, c.Level, c.Status, c.InstructorID, c.CreatedAt, c.UpdatedAt,
+		c.IsFree, c.Level, c.Status, c.InstructorID, c.PublishAt, c.UnpublishAt, c.CreatedAt, c.UpdatedAt,
 	)
 
 	if err != nil {
+		// Another save took the slug between the check and the insert
+		if isUniqueViolation(err) {
+			return domain.ErrSlugAlreadyExists
+		}
 		return fmt.Errorf("failed to create course: %w", err)
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 // GetCourseByID retrieves a course by ID with sections and lessons
@@ -63,7 +86,7 @@ func (r *coursePgRepository) GetCourseByID(ctx context.Context, id string) (*cou
 	err := r.db.GetContext(ctx, c, query, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("course not found")
+			return nil, course.ErrCourseNotFound
 		}
 		return nil, err
 	}
@@ -78,7 +101,7 @@ func (r *coursePgRepository) GetCourseByID(ctx context.Context, id string) (*cou
 	return c, nil
 }
 
-// GetCourseBySlug retrieves a course by slug
+// GetCourseBySlug retrieves a published course by slug
 func (r *coursePgRepository) GetCourseBySlug(ctx context.Context, slugStr string) (*course.Course, error) {
 	c := &course.Course{}
 
@@ -89,14 +112,14 @@ func (r *coursePgRepository) GetCourseBySlug(ctx context.Context, slugStr string
 		FROM courses c
 		LEFT JOIN course_sections cs ON cs.course_id = c.id
 		LEFT JOIN lessons l ON l.section_id = cs.id
-		WHERE c.slug = $1
+		WHERE c.slug = $1 AND ` + courseVisible + `
 		GROUP BY c.id
 	`
 
 	err := r.db.GetContext(ctx, c, query, slugStr)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("course not found")
+			return nil, course.ErrCourseNotFound
 		}
 		return nil, err
 	}
@@ -130,7 +153,7 @@ func (r *coursePgRepository) GetCourses(ctx context.Context, params course.Cours
 		FROM courses c
 		LEFT JOIN course_sections cs ON cs.course_id = c.id
 		LEFT JOIN lessons l ON l.section_id = cs.id
-		WHERE c.status = 'published'
+		WHERE ` + courseVisible + `
 	`
 
 	args := []interface{}{}
@@ -160,7 +183,11 @@ func (r *coursePgRepository) GetCourses(ctx context.Context, params course.Cours
 		argCount++
 	}
 
-	query += " GROUP BY c.id ORDER BY c.created_at DESC"
+	if params.Cursor != nil {
+		return r.getCoursesAfter(ctx, query, args, *params.Cursor, params.Limit)
+	}
+
+	query += " GROUP BY c.id ORDER BY c.created_at DESC, c.id DESC"
 
 	// Count total
 	countQuery := "SELECT COUNT(*) FROM (" + query + ") as counted"
@@ -190,6 +217,33 @@ func (r *coursePgRepository) GetCourses(ctx context.Context, params course.Cours
 	}, nil
 }
 
+// getCoursesAfter is the keyset mode of GetCourses: query is its filtered
+// SELECT, continued here past the cursor and one row beyond the limit to tell
+// whether a next page exists
+func (r *coursePgRepository) getCoursesAfter(ctx context.Context, query string, args []interface{}, cursor pagination.Cursor, limit int) (*course.CourseListResponse, error) {
+	if !cursor.IsStart() {
+		query += fmt.Sprintf(" AND (c.created_at, c.id) < ($%d, $%d)", len(args)+1, len(args)+2)
+		args = append(args, cursor.Time, cursor.ID)
+	}
+	query += fmt.Sprintf(" GROUP BY c.id ORDER BY c.created_at DESC, c.id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit+1)
+
+	courses := []*course.Course{}
+	if err := r.db.SelectContext(ctx, &courses, query, args...); err != nil {
+		return nil, err
+	}
+
+	response := &course.CourseListResponse{}
+	if len(courses) > limit {
+		courses = courses[:limit]
+		last := courses[limit-1]
+		response.NextCursor = pagination.Cursor{Time: last.CreatedAt, ID: last.ID}.Encode()
+	}
+	response.Courses = courses
+
+	return response, nil
+}
+
 // GetAllCourses retrieves all courses including drafts (admin only)
 func (r *coursePgRepository) GetAllCourses(ctx context.Context, params course.CourseListParams) (*course.CourseListResponse, error) {
 	if params.Page < 1 {
@@ -239,7 +293,7 @@ func (r *coursePgRepository) GetAllCourses(ctx context.Context, params course.Co
 		argCount++
 	}
 
-	query += " GROUP BY c.id ORDER BY c.created_at DESC"
+	query += " GROUP BY c.id ORDER BY c.created_at DESC, c.id DESC"
 
 	// Count total
 	countQuery := "SELECT COUNT(*) FROM (" + query + ") as counted"
@@ -271,13 +325,23 @@ func (r *coursePgRepository) GetAllCourses(ctx context.Context, params course.Co
 
 // UpdateCourse updates a course
 func (r *coursePgRepository) UpdateCourse(ctx context.Context, id string, updates course.UpdateCourseRequest) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := "UPDATE courses SET updated_at = $1"
 	args := []interface{}{time.Now()}
 	argCount := 2
 
 	if updates.Title != nil {
+		newSlug, err := uniqueSlug(ctx, tx, domain.SlugContentCourse, slug.Make(*updates.Title), id)
+		if err != nil {
+			return err
+		}
 		query += fmt.Sprintf(", title = $%d, slug = $%d", argCount, argCount+1)
-		args = append(args, *updates.Title, slug.Make(*updates.Title))
+		args = append(args, *updates.Title, newSlug)
 		argCount += 2
 	}
 	if updates.Description != nil {
@@ -309,13 +373,37 @@ func (r *coursePgRepository) UpdateCourse(ctx context.Context, id string, update
 		query += fmt.Sprintf(", status = $%d", argCount)
 		args = append(args, *updates.Status)
 		argCount++
+		// Publishing or unpublishing by hand drops a pending publish_at, so
+		// the scheduler does not undo it
+		if updates.PublishAt == nil && !updates.ClearSchedule {
+			query += ", publish_at = NULL"
+		}
+	}
+	if updates.ClearSchedule {
+		query += ", publish_at = NULL, unpublish_at = NULL"
+	}
+	if updates.PublishAt != nil {
+		query += fmt.Sprintf(", publish_at = $%d", argCount)
+		args = append(args, *updates.PublishAt)
+		argCount++
+	}
+	if updates.UnpublishAt != nil {
+		query += fmt.Sprintf(", unpublish_at = $%d", argCount)
+		args = append(args, *updates.UnpublishAt)
+		argCount++
 	}
 
 	query += fmt.Sprintf(" WHERE id = $%d", argCount)
 	args = append(args, id)
 
-	_, err := r.db.ExecContext(ctx, query, args...)
-	return err
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrSlugAlreadyExists
+		}
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // DeleteCourse deletes a course
@@ -325,6 +413,38 @@ func (r *coursePgRepository) DeleteCourse(ctx context.Context, id string) error
 	return err
 }
 
+// ApplyPublishSchedule publishes courses whose publish_at has passed and
+// moves courses whose unpublish_at has passed back to draft
+func (r *coursePgRepository) ApplyPublishSchedule(ctx context.Context, now time.Time) (int, int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE courses SET status = 'published', publish_at = NULL, updated_at = $1
+		WHERE publish_at IS NOT NULL AND publish_at <= $1`, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to publish scheduled courses: %w", err)
+	}
+	published, _ := result.RowsAffected()
+
+	result, err = tx.ExecContext(ctx, `
+		UPDATE courses SET status = 'draft', unpublish_at = NULL, updated_at = $1
+		WHERE unpublish_at IS NOT NULL AND unpublish_at <= $1`, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to unpublish expired courses: %w", err)
+	}
+	unpublished, _ := result.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+
+	return int(published), int(unpublished), nil
+}
+
 // CreateSection creates a new course section
 func (r *coursePgRepository) CreateSection(ctx context.Context, s *course.Section) error {
 	s.ID = uuid.New().String()
@@ -586,3 +706,33 @@ func (r *coursePgRepository) GetCourseProgress(ctx context.Context, userID, cour
 	err := r.db.GetContext(ctx, &progress, query, userID, courseID)
 	return progress, err
 }
+
+// BulkUpdate publishes, unpublishes or deletes many courses in a single
+// transaction. Publishing or unpublishing by hand drops a pending publish_at.
+func (r *coursePgRepository) BulkUpdate(ctx context.Context, req bulk.Request) (*bulk.Report, error) {
+	return runBulk(ctx, r.db, req.Action, req.IDs, func(tx *sqlx.Tx, id string) (bool, error) {
+		var status string
+		err := tx.GetContext(ctx, &status, `SELECT status FROM courses WHERE id = $1 FOR UPDATE`, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, course.ErrCourseNotFound
+			}
+			return false, err
+		}
+
+		switch req.Action {
+		case bulk.ActionPublish:
+			return affected(ctx, tx, `
+				UPDATE courses SET status = 'published', publish_at = NULL, updated_at = NOW()
+				WHERE id = $1 AND status <> 'published'`, id)
+		case bulk.ActionUnpublish:
+			return affected(ctx, tx, `
+				UPDATE courses SET status = 'draft', publish_at = NULL, updated_at = NOW()
+				WHERE id = $1 AND status = 'published'`, id)
+		case bulk.ActionDelete:
+			return affected(ctx, tx, "DELETE FROM courses WHERE id = $1", id)
+		}
+
+		return false, bulk.ErrUnsupportedAction
+	})
+}
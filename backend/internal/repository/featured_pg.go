@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type featuredPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewFeaturedPostgresRepository(db *sqlx.DB) domain.FeaturedRepository {
+	return &featuredPostgresRepository{
+		db: db,
+	}
+}
+
+const featuredSelect = `
+		SELECT id, slot, content_type, content_id, position, starts_at, ends_at, created_by, created_at, updated_at
+		FROM featured_items`
+
+func (r *featuredPostgresRepository) GetBySlot(ctx context.Context, slot string) ([]*domain.FeaturedItem, error) {
+	items := []*domain.FeaturedItem{}
+	err := r.db.SelectContext(ctx, &items, featuredSelect+`
+		WHERE slot = $1
+		ORDER BY position, created_at`, slot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get featured entries: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *featuredPostgresRepository) GetActive(ctx context.Context, now time.Time) ([]*domain.FeaturedItem, error) {
+	items := []*domain.FeaturedItem{}
+	err := r.db.SelectContext(ctx, &items, featuredSelect+`
+		WHERE (starts_at IS NULL OR starts_at <= $1) AND (ends_at IS NULL OR ends_at > $1)
+		ORDER BY slot, position, created_at`, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active featured entries: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *featuredPostgresRepository) GetByID(ctx context.Context, id string) (*domain.FeaturedItem, error) {
+	var item domain.FeaturedItem
+	err := r.db.GetContext(ctx, &item, featuredSelect+`
+		WHERE id = $1`, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrFeaturedItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get featured entry: %w", err)
+	}
+
+	return &item, nil
+}
+
+func (r *featuredPostgresRepository) Create(ctx context.Context, item *domain.FeaturedItem) error {
+	query := `
+		INSERT INTO featured_items (id, slot, content_type, content_id, position, starts_at, ends_at, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4,
+			CASE WHEN $5 >= 0 THEN $5 ELSE (SELECT COALESCE(MAX(position) + 1, 1) FROM featured_items WHERE slot = $2) END,
+			$6, $7, $8, NOW(), NOW())
+		RETURNING position, created_at, updated_at`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		item.ID, item.Slot, item.ContentType, item.ContentID, item.Position,
+		item.StartsAt, item.EndsAt, item.CreatedBy,
+	).Scan(&item.Position, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrFeaturedItemExists
+		}
+		return fmt.Errorf("failed to create featured entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *featuredPostgresRepository) Update(ctx context.Context, item *domain.FeaturedItem) error {
+	err := r.db.QueryRowxContext(ctx, `
+		UPDATE featured_items SET position = $2, starts_at = $3, ends_at = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`,
+		item.ID, item.Position, item.StartsAt, item.EndsAt,
+	).Scan(&item.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrFeaturedItemNotFound
+		}
+		return fmt.Errorf("failed to update featured entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *featuredPostgresRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM featured_items WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete featured entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete featured entry: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrFeaturedItemNotFound
+	}
+
+	return nil
+}
+
+func (r *featuredPostgresRepository) Reorder(ctx context.Context, slot string, ids []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var current []string
+	err = tx.SelectContext(ctx, &current, `SELECT id FROM featured_items WHERE slot = $1 FOR UPDATE`, slot)
+	if err != nil {
+		return fmt.Errorf("failed to get featured entries: %w", err)
+	}
+	if !sameIDs(current, ids) {
+		return domain.ErrInvalidFeaturedOrder
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE featured_items f SET position = o.position, updated_at = NOW()
+		FROM unnest($2::varchar[]) WITH ORDINALITY AS o(id, position)
+		WHERE f.id = o.id AND f.slot = $1`, slot, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to reorder featured entries: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// sameIDs reports whether ids holds exactly the IDs in current, each once
+func sameIDs(current, ids []string) bool {
+	if len(current) != len(ids) {
+		return false
+	}
+	remaining := make(map[string]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
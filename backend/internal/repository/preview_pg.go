@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type previewPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPreviewPostgresRepository(db *sqlx.DB) domain.PreviewRepository {
+	return &previewPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *previewPostgresRepository) Create(ctx context.Context, token *domain.PreviewToken) error {
+	query := `
+		INSERT INTO preview_tokens (id, content_type, content_id, token_hash, created_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		token.ID, token.ContentType, token.ContentID, token.TokenHash,
+		token.CreatedBy, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create preview token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *previewPostgresRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PreviewToken, error) {
+	query := `
+		SELECT id, content_type, content_id, token_hash, created_by, expires_at, revoked_at, created_at
+		FROM preview_tokens
+		WHERE token_hash = $1`
+
+	var token domain.PreviewToken
+	err := r.db.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrPreviewTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get preview token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (r *previewPostgresRepository) GetByContent(ctx context.Context, contentType, contentID string) ([]*domain.PreviewToken, error) {
+	query := `
+		SELECT id, content_type, content_id, token_hash, created_by, expires_at, revoked_at, created_at
+		FROM preview_tokens
+		WHERE content_type = $1 AND content_id = $2
+		ORDER BY created_at DESC`
+
+	tokens := []*domain.PreviewToken{}
+	if err := r.db.SelectContext(ctx, &tokens, query, contentType, contentID); err != nil {
+		return nil, fmt.Errorf("failed to get preview tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *previewPostgresRepository) Revoke(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE preview_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke preview token: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke preview token: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrPreviewTokenNotFound
+	}
+
+	return nil
+}
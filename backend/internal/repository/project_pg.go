@@ -9,7 +9,11 @@ import (
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/jmoiron/sqlx"
 
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
+	"portfolio/internal/domain/pagination"
 	"portfolio/internal/domain/project"
 )
 
@@ -24,8 +28,18 @@ func NewProjectPostgresRepository(db *sql.DB) project.Repository {
 
 // Create creates a new project
 func (r *projectPostgresRepository) Create(ctx context.Context, proj *project.Project) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	// Generate slug
-	proj.Slug = slug.Make(proj.Title)
+	projectSlug, err := uniqueSlug(ctx, tx, domain.SlugContentProject, slug.Make(proj.Title), proj.ID)
+	if err != nil {
+		return err
+	}
+	proj.Slug = projectSlug
 
 	// Set default status if not provided
 	if proj.Status == "" {
@@ -53,7 +67,7 @@ func (r *projectPostgresRepository) Create(ctx context.Context, proj *project.Pr
 		RETURNING id, created_at, updated_at
 	`
 
-	err = r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		proj.Title,
 		proj.Description,
 		proj.ShortDescription,
@@ -69,8 +83,15 @@ func (r *projectPostgresRepository) Create(ctx context.Context, proj *project.Pr
 		proj.CompletedAt,
 		proj.Slug,
 	).Scan(&proj.ID, &proj.CreatedAt, &proj.UpdatedAt)
+	if err != nil {
+		// Another save took the slug between the check and the insert
+		if isUniqueViolation(err) {
+			return domain.ErrSlugAlreadyExists
+		}
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
 // GetByID retrieves a project by ID
@@ -109,7 +130,7 @@ func (r *projectPostgresRepository) GetByID(ctx context.Context, id string) (*pr
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("project not found")
+			return nil, project.ErrProjectNotFound
 		}
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
@@ -124,7 +145,7 @@ func (r *projectPostgresRepository) GetByID(ctx context.Context, id string) (*pr
 	return &proj, nil
 }
 
-// GetBySlug retrieves a project by slug
+// GetBySlug retrieves a published project by slug; drafts are not found
 func (r *projectPostgresRepository) GetBySlug(ctx context.Context, slugStr string) (*project.Project, error) {
 	query := `
 		SELECT id, title, description, short_description, thumbnail_url,
@@ -132,7 +153,7 @@ func (r *projectPostgresRepository) GetBySlug(ctx context.Context, slugStr strin
 			   display_order, status, started_at, completed_at, slug,
 			   created_at, updated_at
 		FROM projects
-		WHERE slug = $1
+		WHERE slug = $1 AND status = 'published'
 	`
 
 	var proj project.Project
@@ -160,7 +181,7 @@ func (r *projectPostgresRepository) GetBySlug(ctx context.Context, slugStr strin
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("project not found")
+			return nil, project.ErrProjectNotFound
 		}
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
@@ -268,10 +289,20 @@ func (r *projectPostgresRepository) Update(ctx context.Context, id string, updat
 		return fmt.Errorf("no fields to update")
 	}
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	// Always update slug if title changed
 	if updates.Title != "" {
+		projectSlug, err := uniqueSlug(ctx, tx, domain.SlugContentProject, slug.Make(updates.Title), id)
+		if err != nil {
+			return err
+		}
 		setClauses = append(setClauses, fmt.Sprintf("slug = $%d", argPos))
-		args = append(args, slug.Make(updates.Title))
+		args = append(args, projectSlug)
 		argPos++
 	}
 
@@ -289,8 +320,14 @@ func (r *projectPostgresRepository) Update(ctx context.Context, id string, updat
 		WHERE id = $%d
 	`, strings.Join(setClauses, ", "), argPos)
 
-	_, err := r.db.ExecContext(ctx, query, args...)
-	return err
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrSlugAlreadyExists
+		}
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // Delete deletes a project
@@ -333,7 +370,22 @@ func (r *projectPostgresRepository) GetAll(ctx context.Context, filters map[stri
 		argPos++
 	}
 
-	query += " ORDER BY display_order ASC, created_at DESC"
+	// Keyset pagination follows the sort order: display_order up, then newest first
+	if after, ok := filters["after"].(pagination.Cursor); ok && !after.IsStart() {
+		query += fmt.Sprintf(` AND (display_order > $%d OR (display_order = $%d AND
+			(created_at < $%d OR (created_at = $%d AND id::text < $%d))))`,
+			argPos, argPos, argPos+1, argPos+1, argPos+2)
+		args = append(args, after.Order, after.Time, after.ID)
+		argPos += 3
+	}
+
+	query += " ORDER BY display_order ASC, created_at DESC, id::text DESC"
+
+	if limit, ok := filters["limit"].(int); ok && limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, limit)
+		argPos++
+	}
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -381,3 +433,49 @@ func (r *projectPostgresRepository) GetAll(ctx context.Context, filters map[stri
 
 	return projects, nil
 }
+
+// BulkUpdate applies one action to many projects in a single transaction.
+// Tags are the project's technologies.
+func (r *projectPostgresRepository) BulkUpdate(ctx context.Context, req bulk.Request) (*bulk.Report, error) {
+	return runBulk(ctx, sqlx.NewDb(r.db, "postgres"), req.Action, req.IDs, func(tx *sqlx.Tx, id string) (bool, error) {
+		var locked string
+		err := tx.GetContext(ctx, &locked, `SELECT id FROM projects WHERE id = $1 FOR UPDATE`, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, project.ErrProjectNotFound
+			}
+			return false, err
+		}
+
+		switch req.Action {
+		case bulk.ActionPublish, bulk.ActionUnpublish:
+			status := "draft"
+			if req.Action == bulk.ActionPublish {
+				status = "published"
+			}
+			return affected(ctx, tx, `
+				UPDATE projects SET status = $2, updated_at = NOW()
+				WHERE id = $1 AND status IS DISTINCT FROM $2`, id, status)
+		case bulk.ActionFeature, bulk.ActionUnfeature:
+			return affected(ctx, tx, `
+				UPDATE projects SET featured = $2, updated_at = NOW()
+				WHERE id = $1 AND featured IS DISTINCT FROM $2`, id, req.Action == bulk.ActionFeature)
+		case bulk.ActionChangeCategory:
+			return affected(ctx, tx, `
+				UPDATE projects SET category = $2, updated_at = NOW()
+				WHERE id = $1 AND category IS DISTINCT FROM $2`, id, req.Value)
+		case bulk.ActionAddTag:
+			return affected(ctx, tx, `
+				UPDATE projects SET technologies = COALESCE(technologies, '[]'::jsonb) || to_jsonb($2::text), updated_at = NOW()
+				WHERE id = $1 AND NOT COALESCE(technologies, '[]'::jsonb) ? $2`, id, req.Value)
+		case bulk.ActionRemoveTag:
+			return affected(ctx, tx, `
+				UPDATE projects SET technologies = technologies - $2::text, updated_at = NOW()
+				WHERE id = $1 AND technologies ? $2`, id, req.Value)
+		case bulk.ActionDelete:
+			return affected(ctx, tx, `DELETE FROM projects WHERE id = $1`, id)
+		}
+
+		return false, bulk.ErrUnsupportedAction
+	})
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type seoPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewSEOPostgresRepository(db *sqlx.DB) domain.SEORepository {
+	return &seoPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *seoPostgresRepository) GetOverride(ctx context.Context, contentType, contentID string) (*domain.SEOOverride, error) {
+	query := `
+		SELECT content_type, content_id, title, description, image, canonical_url, no_index, updated_at
+		FROM seo_overrides
+		WHERE content_type = $1 AND content_id = $2`
+
+	var override domain.SEOOverride
+	err := r.db.GetContext(ctx, &override, query, contentType, contentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSEOOverrideNotFound
+		}
+		return nil, fmt.Errorf("failed to get seo override: %w", err)
+	}
+
+	return &override, nil
+}
+
+func (r *seoPostgresRepository) UpsertOverride(ctx context.Context, override *domain.SEOOverride) error {
+	query := `
+		INSERT INTO seo_overrides (content_type, content_id, title, description, image, canonical_url, no_index, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (content_type, content_id) DO UPDATE SET
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			image = EXCLUDED.image,
+			canonical_url = EXCLUDED.canonical_url,
+			no_index = EXCLUDED.no_index,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err := r.db.ExecContext(ctx, query,
+		override.ContentType, override.ContentID, override.Title, override.Description,
+		override.Image, override.CanonicalURL, override.NoIndex, override.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save seo override: %w", err)
+	}
+
+	return nil
+}
+
+func (r *seoPostgresRepository) DeleteOverride(ctx context.Context, contentType, contentID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM seo_overrides WHERE content_type = $1 AND content_id = $2", contentType, contentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete seo override: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSEOOverrideNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type seriesPostgresRepository struct {
+	db       *sqlx.DB
+	articles *articlePostgresRepository
+}
+
+func NewSeriesPostgresRepository(db *sqlx.DB) domain.SeriesRepository {
+	return &seriesPostgresRepository{
+		db:       db,
+		articles: &articlePostgresRepository{db: db},
+	}
+}
+
+// Series database model
+type seriesDB struct {
+	ID           string    `db:"id"`
+	Title        string    `db:"title"`
+	Slug         string    `db:"slug"`
+	Description  string    `db:"description"`
+	ArticleCount int       `db:"article_count"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+const seriesSelect = `
+		SELECT s.id, s.title, s.slug, s.description, s.created_at, s.updated_at,
+			(SELECT COUNT(*) FROM series_articles sa WHERE sa.series_id = s.id) AS article_count
+		FROM series s`
+
+func (r *seriesPostgresRepository) GetAll(ctx context.Context, publicOnly bool) ([]*domain.Series, error) {
+	query := seriesSelect + ` ORDER BY s.created_at DESC`
+	if publicOnly {
+		query = `
+		SELECT s.id, s.title, s.slug, s.description, s.created_at, s.updated_at,
+			COUNT(a.id) AS article_count
+		FROM series s
+		INNER JOIN series_articles sa ON sa.series_id = s.id
+		INNER JOIN articles a ON a.id = sa.article_id
+		WHERE ` + articleVisible + `
+		GROUP BY s.id
+		ORDER BY s.created_at DESC`
+	}
+
+	var rows []seriesDB
+	err := r.db.SelectContext(ctx, &rows, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	series := make([]*domain.Series, len(rows))
+	for i := range rows {
+		series[i] = r.dbToSeries(&rows[i])
+	}
+
+	return series, nil
+}
+
+func (r *seriesPostgresRepository) GetByID(ctx context.Context, id string, publicOnly bool) (*domain.Series, error) {
+	return r.getOne(ctx, `WHERE s.id = $1`, id, publicOnly)
+}
+
+func (r *seriesPostgresRepository) GetBySlug(ctx context.Context, slug string, publicOnly bool) (*domain.Series, error) {
+	return r.getOne(ctx, `WHERE s.slug = $1`, slug, publicOnly)
+}
+
+func (r *seriesPostgresRepository) GetByArticle(ctx context.Context, articleID string, publicOnly bool) (*domain.Series, error) {
+	return r.getOne(ctx, `WHERE s.id = (SELECT series_id FROM series_articles WHERE article_id = $1)`, articleID, publicOnly)
+}
+
+func (r *seriesPostgresRepository) getOne(ctx context.Context, where string, arg interface{}, publicOnly bool) (*domain.Series, error) {
+	var row seriesDB
+	err := r.db.GetContext(ctx, &row, seriesSelect+"\n\t\t"+where, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	series := r.dbToSeries(&row)
+	series.Articles, err = r.getArticles(ctx, series.ID, publicOnly)
+	if err != nil {
+		return nil, err
+	}
+	if publicOnly {
+		series.ArticleCount = len(series.Articles)
+	}
+
+	return series, nil
+}
+
+func (r *seriesPostgresRepository) getArticles(ctx context.Context, seriesID string, publicOnly bool) ([]*domain.Article, error) {
+	query := articleSelect + `
+		INNER JOIN series_articles sa ON sa.article_id = a.id
+		WHERE sa.series_id = $1`
+	if publicOnly {
+		query += ` AND ` + articleVisible
+	}
+	query += `
+		ORDER BY sa.position`
+
+	var rows []articleDB
+	err := r.db.SelectContext(ctx, &rows, query, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series articles: %w", err)
+	}
+
+	articles := make([]*domain.Article, len(rows))
+	for i := range rows {
+		articles[i] = r.articles.dbToArticle(&rows[i])
+	}
+
+	return articles, nil
+}
+
+func (r *seriesPostgresRepository) Create(ctx context.Context, series *domain.Series, articleIDs []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO series (id, title, slug, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		series.ID, series.Title, series.Slug, series.Description, series.CreatedAt, series.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create series: %w", err)
+	}
+
+	if err := r.setArticles(ctx, tx, series.ID, articleIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *seriesPostgresRepository) Update(ctx context.Context, series *domain.Series, articleIDs *[]string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE series SET title = $1, slug = $2, description = $3, updated_at = NOW()
+		WHERE id = $4`,
+		series.Title, series.Slug, series.Description, series.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update series: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSeriesNotFound
+	}
+
+	if articleIDs != nil {
+		if err := r.setArticles(ctx, tx, series.ID, *articleIDs); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// setArticles replaces the ordered parts of a series
+func (r *seriesPostgresRepository) setArticles(ctx context.Context, tx *sqlx.Tx, seriesID string, articleIDs []string) error {
+	var taken int
+	err := tx.GetContext(ctx, &taken,
+		"SELECT COUNT(*) FROM series_articles WHERE article_id = ANY($1) AND series_id <> $2",
+		pq.Array(articleIDs), seriesID)
+	if err != nil {
+		return fmt.Errorf("failed to check series membership: %w", err)
+	}
+	if taken > 0 {
+		return domain.ErrArticleInAnotherSeries
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM series_articles WHERE series_id = $1", seriesID)
+	if err != nil {
+		return fmt.Errorf("failed to clear series articles: %w", err)
+	}
+
+	if len(articleIDs) == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO series_articles (series_id, article_id, position)
+		SELECT $1, part.id, part.position
+		FROM unnest($2::varchar[]) WITH ORDINALITY AS part(id, position)`,
+		seriesID, pq.Array(articleIDs))
+	if err != nil {
+		return fmt.Errorf("failed to insert series articles: %w", err)
+	}
+
+	return nil
+}
+
+func (r *seriesPostgresRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM series WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete series: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSeriesNotFound
+	}
+
+	return nil
+}
+
+func (r *seriesPostgresRepository) dbToSeries(row *seriesDB) *domain.Series {
+	return &domain.Series{
+		ID:           row.ID,
+		Title:        row.Title,
+		Slug:         row.Slug,
+		Description:  row.Description,
+		ArticleCount: row.ArticleCount,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
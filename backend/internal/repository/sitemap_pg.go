@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type sitemapPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewSitemapPostgresRepository(db *sqlx.DB) domain.SitemapRepository {
+	return &sitemapPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *sitemapPostgresRepository) GetArticleEntries(ctx context.Context) ([]domain.SitemapEntry, error) {
+	query := `
+		SELECT a.slug, GREATEST(a.content_updated_at, a.published_at) AS lastmod
+		FROM articles a
+		WHERE ` + articleVisible + `
+		ORDER BY a.published_at DESC`
+
+	return r.selectEntries(ctx, "articles", query)
+}
+
+func (r *sitemapPostgresRepository) GetProjectEntries(ctx context.Context) ([]domain.SitemapEntry, error) {
+	query := `
+		SELECT slug, updated_at AS lastmod
+		FROM projects
+		WHERE status = 'published'
+		ORDER BY display_order, created_at DESC`
+
+	return r.selectEntries(ctx, "projects", query)
+}
+
+func (r *sitemapPostgresRepository) GetCourseEntries(ctx context.Context) ([]domain.SitemapEntry, error) {
+	query := `
+		SELECT c.slug, COALESCE(c.updated_at, c.created_at, NOW()) AS lastmod
+		FROM courses c
+		WHERE ` + courseVisible + `
+		ORDER BY c.created_at DESC`
+
+	return r.selectEntries(ctx, "courses", query)
+}
+
+func (r *sitemapPostgresRepository) GetCategoryEntries(ctx context.Context) ([]domain.SitemapEntry, error) {
+	query := `
+		SELECT c.slug, MAX(GREATEST(a.content_updated_at, a.published_at)) AS lastmod
+		FROM categories c
+		INNER JOIN articles a ON a.category_id = c.id
+		WHERE ` + articleVisible + `
+		GROUP BY c.slug
+		ORDER BY c.slug`
+
+	return r.selectEntries(ctx, "categories", query)
+}
+
+func (r *sitemapPostgresRepository) selectEntries(ctx context.Context, what, query string) ([]domain.SitemapEntry, error) {
+	var entries []domain.SitemapEntry
+	err := r.db.SelectContext(ctx, &entries, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s for sitemap: %w", what, err)
+	}
+
+	return entries, nil
+}
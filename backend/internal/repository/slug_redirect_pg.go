@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type slugRedirectPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewSlugRedirectPostgresRepository(db *sqlx.DB) domain.SlugRedirectRepository {
+	return &slugRedirectPostgresRepository{
+		db: db,
+	}
+}
+
+// slugTables maps content types to the table holding their live slugs
+var slugTables = map[string]string{
+	domain.SlugContentArticle: "articles",
+	domain.SlugContentProject: "projects",
+	domain.SlugContentCourse:  "courses",
+}
+
+func (r *slugRedirectPostgresRepository) Resolve(ctx context.Context, contentType, slug string) (string, error) {
+	table, ok := slugTables[contentType]
+	if !ok {
+		return "", domain.ErrSlugRedirectNotFound
+	}
+
+	var current string
+	err := r.db.GetContext(ctx, &current, fmt.Sprintf(`
+		SELECT t.slug FROM slug_redirects r
+		INNER JOIN %s t ON t.id::text = r.content_id
+		WHERE r.content_type = $1 AND r.old_slug = $2`, table),
+		contentType, slug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrSlugRedirectNotFound
+		}
+		return "", fmt.Errorf("failed to resolve slug redirect: %w", err)
+	}
+
+	return current, nil
+}
+
+// slugQueryer is satisfied by *sql.DB, *sqlx.DB and their transactions
+type slugQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// uniqueSlug returns base, or base with the first free numeric suffix
+// ("-2", "-3", ...) when it is taken. Slugs still redirecting to other
+// content count as taken, so old links never start pointing elsewhere;
+// excludeID is the content being saved, whose own slugs are free to reuse.
+// Article slugs also avoid those of article translations.
+func uniqueSlug(ctx context.Context, db slugQueryer, contentType, base, excludeID string) (string, error) {
+	table := slugTables[contentType]
+	query := fmt.Sprintf(`
+		SELECT slug FROM %s WHERE (slug = $1 OR slug LIKE $2) AND id::text <> $3
+		UNION
+		SELECT old_slug FROM slug_redirects
+		WHERE content_type = $4 AND (old_slug = $1 OR old_slug LIKE $2) AND content_id <> $3`, table)
+	if contentType == domain.SlugContentArticle {
+		query += `
+		UNION
+		SELECT slug FROM article_translations WHERE slug = $1 OR slug LIKE $2`
+	}
+
+	rows, err := db.QueryContext(ctx, query, base, slugPattern(base), excludeID, contentType)
+	if err != nil {
+		return "", fmt.Errorf("failed to check slug: %w", err)
+	}
+	defer rows.Close()
+
+	return firstFreeSlug(rows, base)
+}
+
+// uniqueTranslationSlug is uniqueSlug for an article translation, whose slug
+// must not clash with any article, old article slug or other translation
+func uniqueTranslationSlug(ctx context.Context, db slugQueryer, base, articleID, lang string) (string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT slug FROM articles WHERE slug = $1 OR slug LIKE $2
+		UNION
+		SELECT old_slug FROM slug_redirects
+		WHERE content_type = $3 AND (old_slug = $1 OR old_slug LIKE $2)
+		UNION
+		SELECT slug FROM article_translations
+		WHERE (slug = $1 OR slug LIKE $2) AND NOT (article_id = $4 AND language = $5)`,
+		base, slugPattern(base), domain.SlugContentArticle, articleID, lang)
+	if err != nil {
+		return "", fmt.Errorf("failed to check slug: %w", err)
+	}
+	defer rows.Close()
+
+	return firstFreeSlug(rows, base)
+}
+
+// isUniqueViolation reports whether err is a unique constraint failure. A
+// save that loses the race for a slug uniqueSlug found free fails this way.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// slugPattern is a LIKE pattern matching base with any suffix
+func slugPattern(base string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(base) + "-%"
+}
+
+// firstFreeSlug returns base, or base with the first numeric suffix not
+// among the taken slugs in rows
+func firstFreeSlug(rows *sql.Rows, base string) (string, error) {
+	taken := make(map[string]bool)
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return "", fmt.Errorf("failed to scan slug: %w", err)
+		}
+		taken[slug] = true
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to check slug: %w", err)
+	}
+
+	slug := base
+	for n := 2; taken[slug]; n++ {
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+	return slug, nil
+}
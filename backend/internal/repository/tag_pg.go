@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"portfolio/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type tagPostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewTagPostgresRepository(db *sqlx.DB) domain.TagRepository {
+	return &tagPostgresRepository{
+		db: db,
+	}
+}
+
+const tagSelect = `
+		SELECT t.id, t.name, t.slug, t.description, t.created_at, t.updated_at,
+			(SELECT COUNT(*) FROM article_tags at WHERE at.tag_id = t.id) AS article_count
+		FROM tags t`
+
+func (r *tagPostgresRepository) GetAll(ctx context.Context, publicOnly bool) ([]*domain.Tag, error) {
+	query := tagSelect + ` ORDER BY t.name`
+	if publicOnly {
+		query = `
+		SELECT t.id, t.name, t.slug, t.description, t.created_at, t.updated_at,
+			COUNT(a.id) AS article_count
+		FROM tags t
+		INNER JOIN article_tags at ON at.tag_id = t.id
+		INNER JOIN articles a ON a.id = at.article_id
+		WHERE ` + articleVisible + `
+		GROUP BY t.id
+		ORDER BY article_count DESC, t.name`
+	}
+
+	tags := []*domain.Tag{}
+	err := r.db.SelectContext(ctx, &tags, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (r *tagPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.GetContext(ctx, &tag, tagSelect+` WHERE t.id = $1`, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (r *tagPostgresRepository) GetBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.GetContext(ctx, &tag, tagSelect+` WHERE t.slug = $1`, slug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (r *tagPostgresRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE tags SET name = $1, slug = $2, description = $3, updated_at = NOW()
+		WHERE id = $4`,
+		tag.Name, tag.Slug, tag.Description, tag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE article_tags SET name = $1 WHERE tag_id = $2", tag.Name, tag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to rename article tags: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *tagPostgresRepository) Merge(ctx context.Context, sourceIDs []string, targetID string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Articles already tagged with the target only lose the source row
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM article_tags s
+		WHERE s.tag_id = ANY($1)
+		  AND (
+			EXISTS (SELECT 1 FROM article_tags t WHERE t.article_id = s.article_id AND t.tag_id = $2)
+			OR EXISTS (SELECT 1 FROM article_tags o WHERE o.article_id = s.article_id AND o.tag_id = ANY($1) AND o.id < s.id)
+		  )`,
+		pq.Array(sourceIDs), targetID)
+	if err != nil {
+		return fmt.Errorf("failed to remove duplicate article tags: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE article_tags SET tag_id = t.id, name = t.name
+		FROM tags t
+		WHERE t.id = $2 AND article_tags.tag_id = ANY($1)`,
+		pq.Array(sourceIDs), targetID)
+	if err != nil {
+		return fmt.Errorf("failed to move article tags: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM tags WHERE id = ANY($1)", pq.Array(sourceIDs))
+	if err != nil {
+		return fmt.Errorf("failed to delete merged tags: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE tags SET updated_at = NOW() WHERE id = $1", targetID)
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *tagPostgresRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM article_tags WHERE tag_id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete article tags: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTagNotFound
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+const analyticsDayLayout = "2006-01-02"
+
+type analyticsUsecase struct {
+	analyticsRepo domain.AnalyticsRepository
+	articleRepo   domain.ArticleRepository
+	timeout       time.Duration
+}
+
+func NewAnalyticsUsecase(analyticsRepo domain.AnalyticsRepository, articleRepo domain.ArticleRepository, timeout time.Duration) domain.AnalyticsUsecase {
+	return &analyticsUsecase{
+		analyticsRepo: analyticsRepo,
+		articleRepo:   articleRepo,
+		timeout:       timeout,
+	}
+}
+
+func (a *analyticsUsecase) GetArticleAnalytics(ctx context.Context, params domain.ArticleAnalyticsParams) (*domain.ArticleAnalytics, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	// Default to the last DefaultAnalyticsDays days, today included
+	if params.To.IsZero() {
+		params.To = time.Now()
+	}
+	params.To = truncateToDay(params.To)
+	if params.From.IsZero() {
+		params.From = params.To.AddDate(0, 0, -(domain.DefaultAnalyticsDays - 1))
+	}
+	params.From = truncateToDay(params.From)
+
+	if params.From.After(params.To) || params.From.AddDate(0, 0, domain.MaxAnalyticsDays).Before(params.To) {
+		return nil, domain.ErrInvalidAnalyticsRange
+	}
+
+	if params.Limit < 1 {
+		params.Limit = domain.DefaultAnalyticsTopN
+	}
+	if params.Limit > domain.MaxAnalyticsTopN {
+		params.Limit = domain.MaxAnalyticsTopN
+	}
+
+	if params.ArticleID != "" {
+		if _, err := a.articleRepo.GetByID(ctx, params.ArticleID); err != nil {
+			return nil, err
+		}
+	}
+
+	daily, err := a.analyticsRepo.GetDailyTraffic(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ArticleAnalytics{
+		From:  params.From.Format(analyticsDayLayout),
+		To:    params.To.Format(analyticsDayLayout),
+		Daily: fillDailyTraffic(daily, params.From, params.To),
+	}
+	for _, day := range report.Daily {
+		report.Views += day.Views
+		report.Likes += day.Likes
+	}
+
+	report.TopArticles, err = a.analyticsRepo.GetTopArticles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Categories, err = a.analyticsRepo.GetCategoryTraffic(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Referrers, err = a.analyticsRepo.GetTopReferrers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.TopArticles == nil {
+		report.TopArticles = []domain.ArticleTraffic{}
+	}
+	if report.Categories == nil {
+		report.Categories = []domain.CategoryTraffic{}
+	}
+	if report.Referrers == nil {
+		report.Referrers = []domain.ReferrerTraffic{}
+	}
+
+	return report, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// fillDailyTraffic returns one entry per day from `from` to `to`, with zeros
+// for days that had no traffic, so charts get an unbroken series
+func fillDailyTraffic(days []domain.DailyTraffic, from, to time.Time) []domain.DailyTraffic {
+	byDay := make(map[string]domain.DailyTraffic, len(days))
+	for _, day := range days {
+		byDay[day.Day] = day
+	}
+
+	var series []domain.DailyTraffic
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format(analyticsDayLayout)
+		entry, ok := byDay[key]
+		if !ok {
+			entry = domain.DailyTraffic{Day: key}
+		}
+		series = append(series, entry)
+	}
+	return series
+}
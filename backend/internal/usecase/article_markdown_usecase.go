@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+type articleMarkdownUsecase struct {
+	articleUsecase domain.ArticleUsecase
+	categoryRepo   domain.CategoryRepository
+	timeout        time.Duration
+}
+
+// exportPageSize is how many articles are listed per page while exporting
+const exportPageSize = 100
+
+// NewArticleMarkdownUsecase imports and exports articles as Markdown. Every
+// article goes through the article usecase so imports get the same slug,
+// excerpt, read time and revision handling as ones written in the editor.
+func NewArticleMarkdownUsecase(articleUsecase domain.ArticleUsecase, categoryRepo domain.CategoryRepository, timeout time.Duration) domain.ArticleMarkdownUsecase {
+	return &articleMarkdownUsecase{
+		articleUsecase: articleUsecase,
+		categoryRepo:   categoryRepo,
+		timeout:        timeout,
+	}
+}
+
+func (m *articleMarkdownUsecase) ImportMarkdown(ctx context.Context, files []domain.MarkdownFile, author domain.Actor) ([]domain.ArticleImportResult, error) {
+	if author.ID == "" {
+		defaultAuthorID, err := m.articleUsecase.GetDefaultAuthorID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		author.ID = defaultAuthorID
+	}
+
+	existing, err := m.listArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, article := range existing {
+		taken[article.Slug] = true
+	}
+
+	// Each file gets its own timeout, so a large batch is not cut short
+	results := make([]domain.ArticleImportResult, 0, len(files))
+	for _, file := range files {
+		result := m.importFile(ctx, file, author, taken)
+		if result.Status == domain.ImportStatusCreated {
+			taken[result.Slug] = true
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (m *articleMarkdownUsecase) importFile(ctx context.Context, file domain.MarkdownFile, author domain.Actor, taken map[string]bool) domain.ArticleImportResult {
+	result := domain.ArticleImportResult{File: file.Name}
+	fail := func(err error) domain.ArticleImportResult {
+		result.Status = domain.ImportStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	meta, body, err := domain.ParseArticleMarkdown(file.Content)
+	if err != nil {
+		return fail(err)
+	}
+
+	result.Slug = domain.Slugify(meta.Slug)
+	if result.Slug == "" {
+		result.Slug = domain.Slugify(meta.Title)
+	}
+	if taken[result.Slug] {
+		result.Status = domain.ImportStatusSkipped
+		result.Error = domain.ErrSlugAlreadyExists.Error()
+		return result
+	}
+
+	req := domain.CreateArticleRequest{
+		Title:     meta.Title,
+		Excerpt:   meta.Excerpt,
+		Featured:  meta.Featured,
+		Published: meta.Published,
+		Tags:      meta.Tags,
+		Language:  meta.Language,
+		Slug:      result.Slug,
+		Thumbnail: meta.Thumbnail,
+	}
+
+	// The date backdates a published article, or schedules it when in the
+	// future; drafts keep no date
+	if meta.Published {
+		publishAt, err := meta.PublishDate()
+		if err != nil {
+			return fail(err)
+		}
+		req.PublishAt = publishAt
+	}
+
+	content, err := json.Marshal(domain.ParseMarkdown(body))
+	if err != nil {
+		return fail(fmt.Errorf("failed to encode content: %w", err))
+	}
+	req.Content = string(content)
+
+	fileCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	category, err := m.categoryRepo.GetBySlug(fileCtx, meta.Category)
+	if err != nil {
+		return fail(fmt.Errorf("%w: %s", domain.ErrCategoryNotFound, meta.Category))
+	}
+	req.CategoryID = category.ID
+
+	article, err := m.articleUsecase.CreateArticle(fileCtx, req, author)
+	if err != nil {
+		return fail(err)
+	}
+
+	result.Status = domain.ImportStatusCreated
+	result.ArticleID = article.ID
+	result.Slug = article.Slug
+	return result
+}
+
+func (m *articleMarkdownUsecase) ExportMarkdown(ctx context.Context) ([]domain.MarkdownFile, error) {
+	articles, err := m.listArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]domain.MarkdownFile, 0, len(articles))
+	for _, listed := range articles {
+		// Listings leave out tags, so each article is loaded in full
+		article, err := m.articleUsecase.GetArticleByID(ctx, listed.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := domain.FormatArticleMarkdown(article)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, domain.MarkdownFile{Name: article.Slug + ".md", Content: content})
+	}
+
+	return files, nil
+}
+
+// listArticles pages through every article, published or not
+func (m *articleMarkdownUsecase) listArticles(ctx context.Context) ([]*domain.Article, error) {
+	var articles []*domain.Article
+	for page := 1; ; page++ {
+		result, err := m.articleUsecase.GetArticles(ctx, domain.ArticleListParams{Page: page, Limit: exportPageSize})
+		if err != nil {
+			return nil, err
+		}
+		articles = append(articles, result.Articles...)
+		if page >= result.TotalPages {
+			return articles, nil
+		}
+	}
+}
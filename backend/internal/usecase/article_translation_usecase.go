@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"portfolio/internal/domain"
+)
+
+func (a *articleUsecase) GetArticleTranslations(ctx context.Context, articleID string) ([]*domain.ArticleTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.articleRepo.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	return a.translations.GetByArticle(ctx, articleID)
+}
+
+func (a *articleUsecase) SaveArticleTranslation(ctx context.Context, articleID, lang string, req domain.SaveArticleTranslationRequest, actor domain.Actor) (*domain.ArticleTranslation, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if !domain.IsSupportedLanguage(lang) {
+		return nil, domain.ErrUnsupportedLanguage
+	}
+
+	article, err := a.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if lang == article.Language {
+		return nil, domain.ErrTranslationSameLanguage
+	}
+
+	// Reuse the ID when replacing, so the row keeps its identity
+	translation, err := a.translations.Get(ctx, articleID, lang)
+	if err == domain.ErrTranslationNotFound {
+		id, err := generateID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate translation ID: %w", err)
+		}
+		translation = &domain.ArticleTranslation{ID: id, ArticleID: articleID, Language: lang}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := canChangeTranslation(actor, article, translation.Published || req.Published); err != nil {
+		return nil, err
+	}
+
+	// A published translation is public whenever the article is
+	lint, err := a.lintContent(req.Content, req.Published)
+	if err != nil {
+		return nil, err
+	}
+
+	// Render the content the same way as the source for excerpt and read time
+	rendered := &domain.Article{Title: req.Title, Excerpt: strings.TrimSpace(req.Excerpt), Content: req.Content}
+	rendered.RenderContentText()
+	rendered.CalculateReadTime()
+	rendered.GenerateExcerpt()
+	rendered.GenerateSlug()
+	if slug := domain.Slugify(req.Slug); slug != "" {
+		rendered.Slug = slug
+	}
+
+	translation.Title = req.Title
+	translation.Excerpt = rendered.Excerpt
+	translation.Content = req.Content
+	translation.ReadTime = rendered.ReadTime
+	translation.Slug = rendered.Slug
+	translation.Published = req.Published
+
+	action := domain.ArticleActionSaveTranslation
+	if req.Published {
+		action = domain.ArticleActionPublishTranslation
+	}
+	transition, err := newTransition(articleID, action, article.Status, article.Status, lang, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.translations.Save(ctx, translation, transition); err != nil {
+		return nil, err
+	}
+
+	saved, err := a.translations.Get(ctx, articleID, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	saved.Lint = lint
+	return saved, nil
+}
+
+func (a *articleUsecase) DeleteArticleTranslation(ctx context.Context, articleID, lang string, actor domain.Actor) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	article, err := a.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+
+	translation, err := a.translations.Get(ctx, articleID, lang)
+	if err != nil {
+		return err
+	}
+
+	if err := canChangeTranslation(actor, article, translation.Published); err != nil {
+		return err
+	}
+
+	transition, err := newTransition(articleID, domain.ArticleActionDeleteTranslation, article.Status, article.Status, lang, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	return a.translations.Delete(ctx, articleID, lang, transition)
+}
+
+// canChangeTranslation applies the article's editing rules to its
+// translations. A published translation goes live with the article, so
+// publishing, changing or removing one needs an editor, as does any
+// translation of an approved or published article.
+func canChangeTranslation(actor domain.Actor, article *domain.Article, published bool) error {
+	if !actor.CanChange(article) {
+		return domain.ErrNotArticleAuthor
+	}
+	if actor.IsEditor() {
+		return nil
+	}
+	if article.Status == domain.ArticleStatusApproved || article.Status == domain.ArticleStatusPublished {
+		return domain.ErrArticleLocked
+	}
+	if published {
+		return domain.ErrTransitionForbidden
+	}
+	return nil
+}
+
+// localizeArticle lists the article's public language versions and, when
+// lang has a published translation, shows the article in it. Any other
+// language falls back to the source.
+func (a *articleUsecase) localizeArticle(ctx context.Context, article *domain.Article, lang string) error {
+	translations, err := a.translations.GetByArticle(ctx, article.ID)
+	if err != nil {
+		return err
+	}
+
+	links := []domain.TranslationLink{{Language: article.Language, Slug: article.Slug, Source: true}}
+	var chosen *domain.ArticleTranslation
+	for _, translation := range translations {
+		if !translation.Published {
+			continue
+		}
+		links = append(links, domain.TranslationLink{Language: translation.Language, Slug: translation.Slug})
+		if translation.Language == lang {
+			chosen = translation
+		}
+	}
+
+	if chosen != nil {
+		article.ApplyTranslation(chosen)
+	}
+	if len(links) > 1 {
+		article.Translations = links
+	}
+	return nil
+}
+
+// translateArticles shows each listed article in lang where it has a
+// published translation
+func (a *articleUsecase) translateArticles(ctx context.Context, articles []*domain.Article, lang string) error {
+	ids := make([]string, 0, len(articles))
+	for _, article := range articles {
+		if article.Language != lang {
+			ids = append(ids, article.ID)
+		}
+	}
+
+	translations, err := a.translations.GetPublished(ctx, ids, lang)
+	if err != nil {
+		return err
+	}
+
+	for _, article := range articles {
+		if translation, ok := translations[article.ID]; ok {
+			article.ApplyTranslation(translation)
+		}
+	}
+	return nil
+}
@@ -11,19 +11,43 @@ import (
 	"time"
 
 	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
 )
 
 type articleUsecase struct {
 	articleRepo  domain.ArticleRepository
 	categoryRepo domain.CategoryRepository
+	revisionRepo domain.ArticleRevisionRepository
+	relationRepo domain.ArticleRelationRepository
+	seriesRepo   domain.SeriesRepository
+	redirectRepo domain.SlugRedirectRepository
+	workflowRepo domain.ArticleWorkflowRepository
+	translations domain.ArticleTranslationRepository
+	linter       *domain.ContentLinter
+	related      *relatedCache
 	db           *sql.DB // For querying admin user
 	timeout      time.Duration
 }
 
-func NewArticleUsecase(articleRepo domain.ArticleRepository, categoryRepo domain.CategoryRepository, db *sql.DB, timeout time.Duration) domain.ArticleUsecase {
+// Related articles are cached per article for relatedCacheTTL and capped at maxRelatedArticles
+const (
+	relatedCacheTTL     = 10 * time.Minute
+	maxRelatedArticles  = 20
+	defaultRelatedLimit = 4
+)
+
+func NewArticleUsecase(articleRepo domain.ArticleRepository, categoryRepo domain.CategoryRepository, revisionRepo domain.ArticleRevisionRepository, relationRepo domain.ArticleRelationRepository, seriesRepo domain.SeriesRepository, redirectRepo domain.SlugRedirectRepository, workflowRepo domain.ArticleWorkflowRepository, translationRepo domain.ArticleTranslationRepository, linter *domain.ContentLinter, db *sql.DB, timeout time.Duration) domain.ArticleUsecase {
 	return &articleUsecase{
 		articleRepo:  articleRepo,
 		categoryRepo: categoryRepo,
+		revisionRepo: revisionRepo,
+		relationRepo: relationRepo,
+		seriesRepo:   seriesRepo,
+		redirectRepo: redirectRepo,
+		workflowRepo: workflowRepo,
+		translations: translationRepo,
+		linter:       linter,
+		related:      newRelatedCache(relatedCacheTTL),
 		db:           db,
 		timeout:      timeout,
 	}
@@ -33,7 +57,18 @@ func (a *articleUsecase) GetArticles(ctx context.Context, params domain.ArticleL
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
-	return a.articleRepo.GetAll(ctx, params)
+	result, err := a.articleRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Language != "" {
+		if err := a.translateArticles(ctx, result.Articles, params.Language); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 func (a *articleUsecase) GetArticleByID(ctx context.Context, id string) (*domain.Article, error) {
@@ -44,10 +79,19 @@ func (a *articleUsecase) GetArticleByID(ctx context.Context, id string) (*domain
 		return nil, domain.ErrArticleNotFound
 	}
 
-	return a.articleRepo.GetByID(ctx, id)
+	article, err := a.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.attachSeries(ctx, article, false); err != nil {
+		return nil, err
+	}
+
+	return article, nil
 }
 
-func (a *articleUsecase) GetArticleBySlug(ctx context.Context, slug string) (*domain.Article, error) {
+func (a *articleUsecase) GetArticleBySlug(ctx context.Context, slug string, lang domain.LanguageChoice) (*domain.Article, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
@@ -55,7 +99,52 @@ func (a *articleUsecase) GetArticleBySlug(ctx context.Context, slug string) (*do
 		return nil, domain.ErrArticleNotFound
 	}
 
-	return a.articleRepo.GetBySlug(ctx, slug)
+	language := lang.Preferred
+	article, err := a.articleRepo.GetBySlug(ctx, slug)
+	if err == domain.ErrArticleNotFound {
+		// A translation's slug shows that translation unless ?lang= says otherwise
+		translation, terr := a.translations.GetBySlug(ctx, slug)
+		if terr == nil {
+			language = translation.Language
+			article, err = a.articleRepo.GetBySlug(ctx, translation.ArticleSlug)
+		} else if terr != domain.ErrTranslationNotFound {
+			return nil, terr
+		}
+	}
+	if err == domain.ErrArticleNotFound {
+		return nil, domain.ResolveSlugRedirect(ctx, a.redirectRepo, domain.SlugContentArticle, slug, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lang.Explicit != "" {
+		language = lang.Explicit
+	}
+	if err := a.localizeArticle(ctx, article, language); err != nil {
+		return nil, err
+	}
+
+	// Public reads navigate published parts only
+	if err := a.attachSeries(ctx, article, true); err != nil {
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// attachSeries sets the series navigation of an article that belongs to one
+func (a *articleUsecase) attachSeries(ctx context.Context, article *domain.Article, publicOnly bool) error {
+	series, err := a.seriesRepo.GetByArticle(ctx, article.ID, publicOnly)
+	if err != nil {
+		if err == domain.ErrSeriesNotFound {
+			return nil
+		}
+		return err
+	}
+
+	article.Series = series.Navigation(article.ID)
+	return nil
 }
 
 func (a *articleUsecase) GetFeaturedArticle(ctx context.Context) (*domain.Article, error) {
@@ -78,19 +167,67 @@ func (a *articleUsecase) SearchArticles(ctx context.Context, params domain.Searc
 		}, nil
 	}
 
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 || params.Limit > 50 {
+		params.Limit = 10
+	}
+
+	if params.Language != "" && !domain.IsSupportedLanguage(params.Language) {
+		return nil, domain.ErrUnsupportedLanguage
+	}
+
+	if params.Language == "" {
+		params.Prefer = domain.DetectLanguage(params.Query)
+		return a.articleRepo.Search(ctx, params)
+	}
+
+	result, err := a.articleRepo.Search(ctx, params)
+	if err != nil || result.Total > 0 {
+		return result, err
+	}
+
+	// Mixed-language queries may only stem correctly under the other
+	// configuration, so retry across every language with the requested
+	// one still ranked first.
+	params.Prefer = params.Language
+	params.Language = ""
 	return a.articleRepo.Search(ctx, params)
 }
 
-func (a *articleUsecase) CreateArticle(ctx context.Context, req domain.CreateArticleRequest, authorID string) (*domain.Article, error) {
+func (a *articleUsecase) CreateArticle(ctx context.Context, req domain.CreateArticleRequest, author domain.Actor) (*domain.Article, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
+	// Writers start from a draft; publishing and scheduling are for editors
+	if !author.IsWriter() || ((req.Published || req.PublishAt != nil) && !author.IsEditor()) {
+		return nil, domain.ErrTransitionForbidden
+	}
+
 	// Validate category exists
 	category, err := a.categoryRepo.GetByID(ctx, req.CategoryID)
 	if err != nil {
 		return nil, domain.ErrCategoryNotFound
 	}
 
+	if err := domain.ValidateSchedule(req.PublishAt, req.UnpublishAt); err != nil {
+		return nil, err
+	}
+
+	if req.Language != "" && !domain.IsSupportedLanguage(req.Language) {
+		return nil, domain.ErrUnsupportedLanguage
+	}
+
+	if err := domain.ValidateTagNames(req.Tags); err != nil {
+		return nil, err
+	}
+
+	lint, err := a.lintContent(req.Content, req.Published || req.PublishAt != nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate unique ID
 	id, err := generateID()
 	if err != nil {
@@ -103,43 +240,111 @@ func (a *articleUsecase) CreateArticle(ctx context.Context, req domain.CreateArt
 		Title:       req.Title,
 		Excerpt:     req.Excerpt,
 		Content:     req.Content,
+		Thumbnail:   req.Thumbnail,
 		Category:    *category, // Set the full category object
 		Featured:    req.Featured,
 		Published:   req.Published,
+		Status:      domain.ArticleStatusDraft,
 		Tags:        req.Tags,
 		PublishedAt: time.Now(),
 		UpdatedAt:   time.Now(),
+		UnpublishAt: req.UnpublishAt,
 		Author: domain.Author{
-			ID: authorID,
+			ID: author.ID,
 		},
 	}
 
+	// A future publish time keeps the article approved but hidden until the
+	// scheduler flips it; a past one publishes immediately with a backdated date.
+	if req.PublishAt != nil {
+		article.PublishedAt = *req.PublishAt
+		if req.PublishAt.After(time.Now()) {
+			article.Published = false
+			article.PublishAt = req.PublishAt
+		} else {
+			article.Published = true
+		}
+	}
+	if article.Published {
+		article.Status = domain.ArticleStatusPublished
+	} else if article.PublishAt != nil {
+		article.Status = domain.ArticleStatusApproved
+	}
+
 	// Generate slug
 	article.GenerateSlug()
+	if slug := domain.Slugify(req.Slug); slug != "" {
+		article.Slug = slug
+	}
 
-	// Calculate read time
+	// Render plain text for read time, excerpt and search indexing
+	article.RenderContentText()
 	article.CalculateReadTime()
+	article.GenerateExcerpt()
+
+	article.Language = req.Language
+	if article.Language == "" {
+		article.Language = domain.DetectLanguage(article.Title + " " + article.ContentText)
+	}
+
+	// The first revision, and the workflow step of an article that starts
+	// out published or scheduled, are saved with the article
+	revisionID, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate revision ID: %w", err)
+	}
+	history := domain.ArticleHistory{RevisionID: revisionID, AuthorID: author.ID}
+	if article.Status != domain.ArticleStatusDraft {
+		action := domain.ArticleActionPublish
+		if article.Status == domain.ArticleStatusApproved {
+			action = domain.ArticleActionSchedule
+		}
+		history.Transition, err = newTransition(article.ID, action, domain.ArticleStatusDraft, article.Status, "", author.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Save article
-	err = a.articleRepo.Create(ctx, article)
+	err = a.articleRepo.Create(ctx, article, history)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create article: %w", err)
 	}
+	a.related.invalidate()
 
 	// Fetch the complete article with relations
-	return a.articleRepo.GetByID(ctx, article.ID)
+	created, err := a.articleRepo.GetByID(ctx, article.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	created.Lint = lint
+	return created, nil
 }
 
-func (a *articleUsecase) UpdateArticle(ctx context.Context, id string, req domain.UpdateArticleRequest) (*domain.Article, error) {
+func (a *articleUsecase) UpdateArticle(ctx context.Context, id string, req domain.UpdateArticleRequest, editor domain.Actor) (*domain.Article, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
+	if !editor.IsWriter() {
+		return nil, domain.ErrTransitionForbidden
+	}
+
 	// Check if article exists
 	existingArticle, err := a.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if !editor.CanChange(existingArticle) {
+		return nil, domain.ErrNotArticleAuthor
+	}
+
+	// Once approved, changes would go live without review
+	if !editor.IsEditor() && (existingArticle.Status == domain.ArticleStatusApproved || existingArticle.Status == domain.ArticleStatusPublished) {
+		return nil, domain.ErrArticleLocked
+	}
+
 	// Validate category if provided
 	if req.CategoryID != "" && req.CategoryID != existingArticle.Category.ID {
 		_, err := a.categoryRepo.GetByID(ctx, req.CategoryID)
@@ -148,75 +353,291 @@ func (a *articleUsecase) UpdateArticle(ctx context.Context, id string, req domai
 		}
 	}
 
-	// Generate new slug if title changed
-	if req.Title != "" {
-		existingArticle.Title = req.Title
-		existingArticle.GenerateSlug()
-		req.Slug = existingArticle.Slug
+	// Validate the resulting schedule
+	publishAt, unpublishAt := existingArticle.PublishAt, existingArticle.UnpublishAt
+	if req.ClearSchedule {
+		publishAt, unpublishAt = nil, nil
+	}
+	if req.PublishAt != nil {
+		publishAt = req.PublishAt
+	}
+	if req.UnpublishAt != nil {
+		unpublishAt = req.UnpublishAt
+	}
+	if err := domain.ValidateSchedule(publishAt, unpublishAt); err != nil {
+		return nil, err
+	}
+
+	if req.Language != "" && !domain.IsSupportedLanguage(req.Language) {
+		return nil, domain.ErrUnsupportedLanguage
+	}
+
+	if err := domain.ValidateTagNames(req.Tags); err != nil {
+		return nil, err
+	}
+
+	// Publishing, unpublishing and scheduling go through the workflow
+	action := ""
+	switch {
+	case req.PublishAt != nil:
+		// A past publish_at on a live article only backdates it
+		if req.PublishAt.After(time.Now()) || existingArticle.Status != domain.ArticleStatusPublished {
+			action = domain.ArticleActionSchedule
+		} else if !editor.IsEditor() {
+			return nil, domain.ErrTransitionForbidden
+		}
+	case req.Published != nil && *req.Published && existingArticle.Status != domain.ArticleStatusPublished:
+		action = domain.ArticleActionPublish
+	case req.Published != nil && !*req.Published && existingArticle.Status == domain.ArticleStatusPublished:
+		action = domain.ArticleActionArchive
+	}
+	req.Published = nil
+
+	fromStatus := existingArticle.Status
+	if action != "" {
+		toStatus, err := domain.NextArticleStatus(fromStatus, action, editor)
+		if err != nil {
+			return nil, err
+		}
+		published := toStatus == domain.ArticleStatusPublished
+		req.Status = toStatus
+		req.Published = &published
+	}
+
+	// Content that is or is about to be public must pass the blocking rules
+	status := existingArticle.Status
+	if req.Status != "" {
+		status = req.Status
+	}
+	public := status == domain.ArticleStatusPublished || (status == domain.ArticleStatusApproved && publishAt != nil)
+	var lint *domain.LintReport
+	if req.Content != "" || (public && action != "") {
+		content := req.Content
+		if content == "" {
+			content = existingArticle.Content
+		}
+		if lint, err = a.lintContent(content, public); err != nil {
+			return nil, err
+		}
+	}
+
+	// An explicit slug wins. Otherwise a retitled article follows its new
+	// title only while its slug is still the one generated from the old
+	// title, so hand-picked slugs and their links survive a retitle.
+	req.Slug = domain.Slugify(req.Slug)
+	if req.Slug == "" && req.Title != "" && req.Title != existingArticle.Title &&
+		domain.IsGeneratedSlug(existingArticle.Slug, existingArticle.Title) {
+		req.Slug = domain.Slugify(req.Title)
 	}
 
 	// Calculate read time if content changed
 	if req.Content != "" {
 		existingArticle.Content = req.Content
+		existingArticle.RenderContentText()
 		existingArticle.CalculateReadTime()
+		req.ContentText = existingArticle.ContentText
 		req.ReadTime = existingArticle.ReadTime
 	}
 
+	// The revision and any workflow step are saved with the update
+	revisionID, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate revision ID: %w", err)
+	}
+	history := domain.ArticleHistory{RevisionID: revisionID, AuthorID: editor.ID}
+	if action != "" {
+		history.Transition, err = newTransition(id, action, fromStatus, req.Status, "", editor.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update article
-	err = a.articleRepo.Update(ctx, id, req)
+	err = a.articleRepo.Update(ctx, id, req, history)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update article: %w", err)
 	}
+	a.related.invalidate()
+
+	updated, err := a.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated.Lint = lint
+	return updated, nil
+}
+
+func (a *articleUsecase) LintContent(content string) *domain.LintReport {
+	return a.linter.Lint(content)
+}
+
+// lintContent checks content that is saved. Blocking issues refuse it only
+// when it is public or about to be; a draft is saved with the report.
+func (a *articleUsecase) lintContent(content string, public bool) (*domain.LintReport, error) {
+	report := a.linter.Lint(content)
+	if public && report.HasErrors() {
+		return nil, &domain.ContentLintError{Report: report}
+	}
+	return report, nil
+}
+
+// publishable refuses content with blocking lint issues
+func (a *articleUsecase) publishable(content string) error {
+	_, err := a.lintContent(content, true)
+	return err
+}
+
+func (a *articleUsecase) TransitionArticle(ctx context.Context, id string, req domain.ArticleTransitionRequest, actor domain.Actor) (*domain.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	article, err := a.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// Apply other updates to existing article object
-	if req.Excerpt != "" {
-		existingArticle.Excerpt = req.Excerpt
+	if !actor.CanChange(article) {
+		return nil, domain.ErrNotArticleAuthor
 	}
-	if req.Thumbnail != "" {
-		existingArticle.Thumbnail = req.Thumbnail
+
+	toStatus, err := domain.NextArticleStatus(article.Status, req.Action, actor)
+	if err != nil {
+		return nil, err
 	}
-	if req.CategoryID != "" {
-		existingArticle.Category.ID = req.CategoryID
+
+	comment := strings.TrimSpace(req.Comment)
+	if req.Action == domain.ArticleActionRequestChanges && comment == "" {
+		return nil, domain.ErrTransitionCommentRequired
 	}
-	if req.Tags != nil {
-		existingArticle.Tags = req.Tags
+
+	published := toStatus == domain.ArticleStatusPublished
+	updates := domain.UpdateArticleRequest{
+		Status:    toStatus,
+		Published: &published,
 	}
-	if req.Featured != nil {
-		existingArticle.Featured = *req.Featured
+	if req.Action == domain.ArticleActionSchedule {
+		if req.PublishAt == nil || !req.PublishAt.After(time.Now()) {
+			return nil, domain.ErrSchedulePublishAtRequired
+		}
+		if err := domain.ValidateSchedule(req.PublishAt, article.UnpublishAt); err != nil {
+			return nil, err
+		}
+		updates.PublishAt = req.PublishAt
 	}
-	if req.Published != nil {
-		existingArticle.Published = *req.Published
+
+	if req.Action == domain.ArticleActionPublish || req.Action == domain.ArticleActionSchedule {
+		if err := a.publishable(article.Content); err != nil {
+			return nil, err
+		}
 	}
-	existingArticle.UpdatedAt = time.Now()
 
-	return existingArticle, nil
+	transition, err := newTransition(id, req.Action, article.Status, toStatus, comment, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.articleRepo.Update(ctx, id, updates, domain.ArticleHistory{Transition: transition}); err != nil {
+		return nil, fmt.Errorf("failed to update article: %w", err)
+	}
+	a.related.invalidate()
+
+	return a.articleRepo.GetByID(ctx, id)
+}
+
+func (a *articleUsecase) GetArticleTransitions(ctx context.Context, id string) ([]*domain.ArticleTransition, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.articleRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return a.workflowRepo.GetByArticle(ctx, id)
+}
+
+func (a *articleUsecase) BulkArticles(ctx context.Context, req bulk.Request, actor domain.Actor) (*bulk.Report, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	err := req.Normalize(bulk.ActionPublish, bulk.ActionUnpublish, bulk.ActionFeature, bulk.ActionUnfeature,
+		bulk.ActionChangeCategory, bulk.ActionAddTag, bulk.ActionRemoveTag, bulk.ActionDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every bulk action changes or removes articles without review
+	if !actor.IsEditor() {
+		return nil, domain.ErrTransitionForbidden
+	}
+	if req.Action == bulk.ActionAddTag || req.Action == bulk.ActionRemoveTag {
+		if domain.Slugify(req.Value) == "" {
+			return nil, domain.ErrInvalidTagName
+		}
+	}
+
+	report, err := a.articleRepo.Bulk(ctx, req, actor, a.publishable)
+	if err != nil {
+		return nil, err
+	}
+	if report.Applied {
+		a.related.invalidate()
+	}
+
+	return report, nil
 }
 
-func (a *articleUsecase) DeleteArticle(ctx context.Context, id string) error {
+func (a *articleUsecase) DeleteArticle(ctx context.Context, id string, actor domain.Actor) error {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
+	if !actor.IsEditor() {
+		return domain.ErrTransitionForbidden
+	}
+
 	// Check if article exists
 	_, err := a.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	return a.articleRepo.Delete(ctx, id)
+	if err := a.articleRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	a.related.invalidate()
+
+	return nil
+}
+
+func (a *articleUsecase) TrackArticleView(ctx context.Context, id string, visitor domain.Visitor) error {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	// Crawlers and requests we can't attribute would only inflate the count
+	if !visitor.IsKnown() || domain.IsBotUserAgent(visitor.UserAgent) {
+		return nil
+	}
+
+	_, err := a.articleRepo.RecordView(ctx, id, visitor, domain.ViewDedupWindow)
+	return err
 }
 
-func (a *articleUsecase) TrackArticleView(ctx context.Context, id string) error {
+func (a *articleUsecase) ToggleArticleLike(ctx context.Context, id string, visitor domain.Visitor) (*domain.LikeState, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
-	return a.articleRepo.IncrementViewCount(ctx, id)
+	if !visitor.IsKnown() {
+		return nil, domain.ErrUnknownVisitor
+	}
+
+	return a.articleRepo.ToggleLike(ctx, id, visitor)
 }
 
-func (a *articleUsecase) TrackArticleLike(ctx context.Context, id string) (int, error) {
+func (a *articleUsecase) GetArticleLike(ctx context.Context, id string, visitor domain.Visitor) (*domain.LikeState, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.timeout)
 	defer cancel()
 
-	return a.articleRepo.IncrementLikeCount(ctx, id)
+	return a.articleRepo.GetLikeState(ctx, id, visitor)
 }
 
 func (a *articleUsecase) GetArticleStats(ctx context.Context, id string) (*domain.ArticleStats, error) {
@@ -244,6 +665,196 @@ func (a *articleUsecase) GetDefaultAuthorID(ctx context.Context) (string, error)
 	return authorID, nil
 }
 
+func (a *articleUsecase) GetArticleRevisions(ctx context.Context, articleID string) ([]*domain.ArticleRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.articleRepo.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	return a.revisionRepo.GetByArticle(ctx, articleID)
+}
+
+func (a *articleUsecase) GetArticleRevision(ctx context.Context, articleID, revisionID string) (*domain.ArticleRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	return a.revisionRepo.GetByID(ctx, articleID, revisionID)
+}
+
+func (a *articleUsecase) DiffArticleRevisions(ctx context.Context, articleID, fromID, toID string) (*domain.RevisionDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	from, err := a.revisionRepo.GetByID(ctx, articleID, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := a.revisionRepo.GetByID(ctx, articleID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisions(from, to), nil
+}
+
+func (a *articleUsecase) RestoreArticleRevision(ctx context.Context, articleID, revisionID string, editor domain.Actor) (*domain.Article, error) {
+	revision, err := a.GetArticleRevision(ctx, articleID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restoring is a regular update, so it is recorded as a new revision
+	// and the history stays linear.
+	req := domain.UpdateArticleRequest{
+		Title:      revision.Title,
+		Excerpt:    revision.Excerpt,
+		Content:    revision.Content,
+		CategoryID: revision.CategoryID,
+		Tags:       revision.Tags,
+	}
+
+	return a.UpdateArticle(ctx, articleID, req, editor)
+}
+
+func (a *articleUsecase) GetRelatedArticles(ctx context.Context, slug string, limit int) ([]domain.RelatedArticle, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	article, err := a.articleRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.relatedFor(ctx, article, limit)
+}
+
+func (a *articleUsecase) GetRelatedPins(ctx context.Context, articleID string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.articleRepo.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	return a.relationRepo.GetPinnedIDs(ctx, articleID)
+}
+
+func (a *articleUsecase) SetRelatedPins(ctx context.Context, articleID string, relatedIDs []string) ([]domain.RelatedArticle, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	article, err := a.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(relatedIDs) > maxRelatedArticles {
+		return nil, domain.ErrInvalidRelatedArticle
+	}
+
+	seen := make(map[string]bool, len(relatedIDs))
+	for _, id := range relatedIDs {
+		if id == articleID || seen[id] {
+			return nil, domain.ErrInvalidRelatedArticle
+		}
+		seen[id] = true
+
+		if _, err := a.articleRepo.GetByID(ctx, id); err != nil {
+			if err == domain.ErrArticleNotFound {
+				return nil, domain.ErrInvalidRelatedArticle
+			}
+			return nil, err
+		}
+	}
+
+	if err := a.relationRepo.SetPinned(ctx, articleID, relatedIDs); err != nil {
+		return nil, err
+	}
+	a.related.invalidate()
+
+	return a.relatedFor(ctx, article, maxRelatedArticles)
+}
+
+// relatedFor lists pinned articles first, then the best-scoring others
+func (a *articleUsecase) relatedFor(ctx context.Context, article *domain.Article, limit int) ([]domain.RelatedArticle, error) {
+	if limit < 1 {
+		limit = defaultRelatedLimit
+	}
+	if limit > maxRelatedArticles {
+		limit = maxRelatedArticles
+	}
+
+	related, ok := a.related.get(article.ID)
+	if !ok {
+		pinned, err := a.relationRepo.GetPinned(ctx, article.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates, err := a.relationRepo.GetCandidates(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The target needs its tags and text for scoring; visible articles
+		// already carry them in the candidate set.
+		target := article
+		for _, candidate := range candidates {
+			if candidate.ID == article.ID {
+				target = candidate
+				break
+			}
+		}
+		if target == article && article.ContentText == "" {
+			article.RenderContentText()
+		}
+
+		related = make([]domain.RelatedArticle, 0, maxRelatedArticles)
+		isPinned := make(map[string]bool, len(pinned))
+		for _, p := range pinned {
+			related = append(related, domain.RelatedArticle{Article: p, Pinned: true})
+			isPinned[p.ID] = true
+		}
+		for _, scored := range domain.ScoreRelated(target, candidates) {
+			if len(related) >= maxRelatedArticles {
+				break
+			}
+			if !isPinned[scored.Article.ID] {
+				related = append(related, scored)
+			}
+		}
+
+		a.related.set(article.ID, related)
+	}
+
+	if len(related) > limit {
+		related = related[:limit]
+	}
+	return related, nil
+}
+
+// newTransition describes a workflow step for the article's history
+func newTransition(articleID, action, fromStatus, toStatus, comment, actorID string) (*domain.ArticleTransition, error) {
+	id, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate transition ID: %w", err)
+	}
+
+	return &domain.ArticleTransition{
+		ID:         id,
+		ArticleID:  articleID,
+		Action:     action,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Comment:    comment,
+		ActorID:    actorID,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
 // Helper function to generate unique IDs
 func generateID() (string, error) {
 	bytes := make([]byte, 16)
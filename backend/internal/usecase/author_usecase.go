@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+type authorUsecase struct {
+	authorRepo  domain.AuthorRepository
+	articleRepo domain.ArticleRepository
+	timeout     time.Duration
+}
+
+func NewAuthorUsecase(authorRepo domain.AuthorRepository, articleRepo domain.ArticleRepository, timeout time.Duration) domain.AuthorUsecase {
+	return &authorUsecase{
+		authorRepo:  authorRepo,
+		articleRepo: articleRepo,
+		timeout:     timeout,
+	}
+}
+
+func (a *authorUsecase) GetAuthors(ctx context.Context) ([]*domain.AuthorProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	return a.authorRepo.GetAll(ctx)
+}
+
+func (a *authorUsecase) GetAuthor(ctx context.Context, userID string) (*domain.AuthorProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	return a.authorRepo.GetByID(ctx, userID)
+}
+
+func (a *authorUsecase) UpdateProfile(ctx context.Context, userID string, req domain.UpdateAuthorProfileRequest) (*domain.AuthorProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	author, err := a.authorRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	slugSource := req.Slug
+	if strings.TrimSpace(slugSource) == "" {
+		slugSource = author.Name
+	}
+	slug := domain.Slugify(slugSource)
+	if slug == "" {
+		return nil, domain.ErrInvalidAuthorSlug
+	}
+
+	links, err := domain.NormalizeSocialLinks(req.SocialLinks)
+	if err != nil {
+		return nil, err
+	}
+
+	author.Slug = slug
+	author.Bio = strings.TrimSpace(req.Bio)
+	author.Avatar = strings.TrimSpace(req.Avatar)
+	author.SocialLinks = links
+	if err := a.authorRepo.SaveProfile(ctx, author); err != nil {
+		return nil, err
+	}
+
+	return a.authorRepo.GetByID(ctx, userID)
+}
+
+func (a *authorUsecase) GetAuthorPage(ctx context.Context, slug string, page, limit int) (*domain.AuthorProfile, *domain.ArticleListResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	author, err := a.authorRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := true
+	articles, err := a.articleRepo.GetAll(ctx, domain.ArticleListParams{
+		Page:      page,
+		Limit:     limit,
+		AuthorID:  author.ID,
+		Published: &published,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return author, articles, nil
+}
+
+func (a *authorUsecase) ReassignArticle(ctx context.Context, articleID, authorID string) (*domain.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	if _, err := a.authorRepo.GetByID(ctx, authorID); err != nil {
+		if err == domain.ErrAuthorNotFound {
+			return nil, domain.ErrInvalidAuthor
+		}
+		return nil, err
+	}
+
+	if err := a.authorRepo.AssignArticle(ctx, articleID, authorID); err != nil {
+		return nil, err
+	}
+
+	return a.articleRepo.GetByID(ctx, articleID)
+}
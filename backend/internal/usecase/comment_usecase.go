@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"portfolio/internal/domain"
+)
+
+type commentUsecase struct {
+	commentRepo domain.CommentRepository
+	articleRepo domain.ArticleRepository
+	timeout     time.Duration
+}
+
+func NewCommentUsecase(commentRepo domain.CommentRepository, articleRepo domain.ArticleRepository, timeout time.Duration) domain.CommentUsecase {
+	return &commentUsecase{
+		commentRepo: commentRepo,
+		articleRepo: articleRepo,
+		timeout:     timeout,
+	}
+}
+
+func (c *commentUsecase) GetArticleComments(ctx context.Context, slug string) ([]*domain.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	article, err := c.articleRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := c.commentRepo.GetApprovedByArticle(ctx, article.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moderation details are for admins only
+	for _, comment := range comments {
+		comment.SpamScore = 0
+		comment.SpamReasons = nil
+		comment.ModeratedBy = ""
+		comment.ModeratedAt = nil
+	}
+
+	return domain.BuildCommentTree(comments), nil
+}
+
+func (c *commentUsecase) CreateComment(ctx context.Context, slug, userID string, req domain.CreateCommentRequest) (*domain.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, domain.ErrCommentEmpty
+	}
+	if utf8.RuneCountInString(body) > domain.CommentMaxLength {
+		return nil, domain.ErrCommentTooLong
+	}
+
+	article, err := c.articleRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ParentID != "" {
+		parent, err := c.commentRepo.GetByID(ctx, req.ParentID)
+		if err != nil && err != domain.ErrCommentNotFound {
+			return nil, err
+		}
+		if parent == nil || parent.ArticleID != article.ID || parent.Status != domain.CommentStatusApproved {
+			return nil, domain.ErrInvalidCommentParent
+		}
+	}
+
+	// Rate limit per customer
+	now := time.Now()
+	recent, err := c.commentRepo.GetRecentByUser(ctx, userID, now.Add(-domain.CommentRateWindow))
+	if err != nil {
+		return nil, err
+	}
+	if len(recent) >= domain.CommentRateLimit ||
+		(len(recent) > 0 && now.Sub(recent[0].CreatedAt) < domain.CommentMinInterval) {
+		return nil, domain.ErrCommentRateLimited
+	}
+
+	score, reasons := domain.ScoreCommentSpam(body)
+	for _, previous := range recent {
+		if strings.EqualFold(previous.Body, body) {
+			score += 3
+			reasons = append(reasons, "duplicate of a recent comment")
+			break
+		}
+	}
+
+	status := domain.CommentStatusPending
+	if score >= domain.CommentSpamRejectScore {
+		status = domain.CommentStatusRejected
+	}
+
+	id, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate comment ID: %w", err)
+	}
+
+	comment := &domain.Comment{
+		ID:          id,
+		ArticleID:   article.ID,
+		ParentID:    req.ParentID,
+		UserID:      userID,
+		Body:        body,
+		Status:      status,
+		SpamScore:   score,
+		SpamReasons: reasons,
+		CreatedAt:   now,
+	}
+
+	if err := c.commentRepo.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return c.commentRepo.GetByID(ctx, id)
+}
+
+func (c *commentUsecase) ListComments(ctx context.Context, params domain.CommentListParams) (*domain.CommentListResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if params.Status != "" && !domain.IsValidCommentStatus(params.Status) {
+		return nil, domain.ErrInvalidCommentStatus
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 || params.Limit > 100 {
+		params.Limit = 20
+	}
+
+	return c.commentRepo.List(ctx, params)
+}
+
+func (c *commentUsecase) ModerateComment(ctx context.Context, id, status, moderatorID string) (*domain.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if !domain.IsValidCommentStatus(status) {
+		return nil, domain.ErrInvalidCommentStatus
+	}
+
+	if err := c.commentRepo.UpdateStatus(ctx, id, status, moderatorID); err != nil {
+		return nil, err
+	}
+
+	return c.commentRepo.GetByID(ctx, id)
+}
+
+func (c *commentUsecase) DeleteComment(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return c.commentRepo.Delete(ctx, id)
+}
@@ -3,17 +3,22 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
 	"portfolio/internal/domain/course"
 )
 
 type courseUsecase struct {
-	courseRepo course.Repository
+	courseRepo   course.Repository
+	redirectRepo domain.SlugRedirectRepository
 }
 
-func NewCourseUsecase(repo course.Repository) course.Usecase {
+func NewCourseUsecase(repo course.Repository, redirectRepo domain.SlugRedirectRepository) course.Usecase {
 	return &courseUsecase{
-		courseRepo: repo,
+		courseRepo:   repo,
+		redirectRepo: redirectRepo,
 	}
 }
 
@@ -29,6 +34,11 @@ func (u *courseUsecase) CreateCourse(ctx context.Context, req course.CreateCours
 		return nil, fmt.Errorf("invalid status: must be draft or published")
 	}
 
+	// Validate schedule
+	if req.PublishAt != nil && req.UnpublishAt != nil && !req.UnpublishAt.After(*req.PublishAt) {
+		return nil, fmt.Errorf("invalid schedule: unpublish_at must be after publish_at")
+	}
+
 	// Set defaults
 	if req.Level == "" {
 		req.Level = "beginner"
@@ -37,6 +47,16 @@ func (u *courseUsecase) CreateCourse(ctx context.Context, req course.CreateCours
 		req.Status = "draft"
 	}
 
+	// A future publish time keeps the course in draft until the scheduler publishes it
+	if req.PublishAt != nil {
+		if req.PublishAt.After(time.Now()) {
+			req.Status = "draft"
+		} else {
+			req.Status = "published"
+			req.PublishAt = nil
+		}
+	}
+
 	c := &course.Course{
 		Title:        req.Title,
 		Description:  req.Description,
@@ -46,6 +66,8 @@ func (u *courseUsecase) CreateCourse(ctx context.Context, req course.CreateCours
 		Level:        req.Level,
 		Status:       req.Status,
 		InstructorID: instructorID,
+		PublishAt:    req.PublishAt,
+		UnpublishAt:  req.UnpublishAt,
 	}
 
 	err := u.courseRepo.CreateCourse(ctx, c)
@@ -61,9 +83,13 @@ func (u *courseUsecase) GetCourseByID(ctx context.Context, id string) (*course.C
 	return u.courseRepo.GetCourseByID(ctx, id)
 }
 
-// GetCourse retrieves a course by slug
+// GetCourse retrieves a course by slug, or returns a *domain.SlugRedirect
+// error for an old slug
 func (u *courseUsecase) GetCourse(ctx context.Context, slug string, userID *string) (*course.Course, error) {
 	c, err := u.courseRepo.GetCourseBySlug(ctx, slug)
+	if err == course.ErrCourseNotFound {
+		return nil, domain.ResolveSlugRedirect(ctx, u.redirectRepo, domain.SlugContentCourse, slug, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -83,20 +109,26 @@ func (u *courseUsecase) GetCourse(ctx context.Context, slug string, userID *stri
 		}
 	}
 
-	// If not enrolled and not free, hide non-preview lessons
-	if !c.IsEnrolled && !c.IsFree {
-		for i := range c.Sections {
-			filteredLessons := []course.Lesson{}
-			for _, lesson := range c.Sections[i].Lessons {
-				if lesson.IsPreview {
-					filteredLessons = append(filteredLessons, lesson)
-				}
+	hideLockedLessons(c)
+
+	return c, nil
+}
+
+// hideLockedLessons leaves only the preview lessons of a paid course the
+// user is not enrolled in
+func hideLockedLessons(c *course.Course) {
+	if c.IsEnrolled || c.IsFree {
+		return
+	}
+	for i := range c.Sections {
+		filteredLessons := []course.Lesson{}
+		for _, lesson := range c.Sections[i].Lessons {
+			if lesson.IsPreview {
+				filteredLessons = append(filteredLessons, lesson)
 			}
-			c.Sections[i].Lessons = filteredLessons
 		}
+		c.Sections[i].Lessons = filteredLessons
 	}
-
-	return c, nil
 }
 
 // GetCourses retrieves courses with filters (only published)
@@ -125,6 +157,31 @@ func (u *courseUsecase) UpdateCourse(ctx context.Context, id string, req course.
 		}
 	}
 
+	// Validate schedule against the stored values
+	if req.PublishAt != nil || req.UnpublishAt != nil {
+		existing, err := u.courseRepo.GetCourseByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		publishAt, unpublishAt := existing.PublishAt, existing.UnpublishAt
+		if req.ClearSchedule {
+			publishAt, unpublishAt = nil, nil
+		}
+		if req.PublishAt != nil {
+			publishAt = req.PublishAt
+		}
+		if req.UnpublishAt != nil {
+			unpublishAt = req.UnpublishAt
+		}
+		if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+			return fmt.Errorf("invalid schedule: unpublish_at must be after publish_at")
+		}
+		if req.PublishAt != nil && req.PublishAt.After(time.Now()) {
+			draft := "draft"
+			req.Status = &draft
+		}
+	}
+
 	return u.courseRepo.UpdateCourse(ctx, id, req)
 }
 
@@ -133,6 +190,15 @@ func (u *courseUsecase) DeleteCourse(ctx context.Context, id string) error {
 	return u.courseRepo.DeleteCourse(ctx, id)
 }
 
+// BulkCourses publishes, unpublishes or deletes many courses at once.
+// Courses have no featured flag, category or tags.
+func (u *courseUsecase) BulkCourses(ctx context.Context, req bulk.Request) (*bulk.Report, error) {
+	if err := req.Normalize(bulk.ActionPublish, bulk.ActionUnpublish, bulk.ActionDelete); err != nil {
+		return nil, err
+	}
+	return u.courseRepo.BulkUpdate(ctx, req)
+}
+
 // CreateSection creates a new section in a course
 func (u *courseUsecase) CreateSection(ctx context.Context, req course.CreateSectionRequest) (*course.Section, error) {
 	// Verify course exists
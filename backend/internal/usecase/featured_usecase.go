@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/project"
+)
+
+type featuredUsecase struct {
+	featuredRepo    domain.FeaturedRepository
+	articleRepo     domain.ArticleRepository
+	translationRepo domain.ArticleTranslationRepository
+	projectRepo     project.Repository
+	courseRepo      course.Repository
+	timeout         time.Duration
+}
+
+func NewFeaturedUsecase(featuredRepo domain.FeaturedRepository, articleRepo domain.ArticleRepository, translationRepo domain.ArticleTranslationRepository, projectRepo project.Repository, courseRepo course.Repository, timeout time.Duration) domain.FeaturedUsecase {
+	return &featuredUsecase{
+		featuredRepo:    featuredRepo,
+		articleRepo:     articleRepo,
+		translationRepo: translationRepo,
+		projectRepo:     projectRepo,
+		courseRepo:      courseRepo,
+		timeout:         timeout,
+	}
+}
+
+func (f *featuredUsecase) GetSlots(ctx context.Context) (map[string][]*domain.FeaturedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	slots := make(map[string][]*domain.FeaturedItem, len(domain.FeaturedSlots))
+	for _, slot := range domain.FeaturedSlots {
+		items, err := f.featuredRepo.GetBySlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		slots[slot] = items
+	}
+
+	return slots, nil
+}
+
+func (f *featuredUsecase) GetSlot(ctx context.Context, slot string) ([]*domain.FeaturedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	if !domain.IsValidFeaturedSlot(slot) {
+		return nil, domain.ErrInvalidFeaturedSlot
+	}
+
+	return f.featuredRepo.GetBySlot(ctx, slot)
+}
+
+func (f *featuredUsecase) AddItem(ctx context.Context, slot string, req domain.CreateFeaturedItemRequest, createdBy string) (*domain.FeaturedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	if !domain.IsValidFeaturedSlot(slot) {
+		return nil, domain.ErrInvalidFeaturedSlot
+	}
+	if !domain.IsValidFeaturedContentType(req.ContentType) {
+		return nil, domain.ErrInvalidFeaturedContentType
+	}
+	if err := validateFeaturedItem(req.Position, req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+	if err := f.contentExists(ctx, req.ContentType, req.ContentID); err != nil {
+		return nil, err
+	}
+
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+
+	item := &domain.FeaturedItem{
+		ID:          id,
+		Slot:        slot,
+		ContentType: req.ContentType,
+		ContentID:   req.ContentID,
+		Position:    -1,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		CreatedBy:   createdBy,
+	}
+	if req.Position != nil {
+		item.Position = *req.Position
+	}
+	if err := f.featuredRepo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (f *featuredUsecase) UpdateItem(ctx context.Context, slot, id string, req domain.UpdateFeaturedItemRequest) (*domain.FeaturedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	item, err := f.slotItem(ctx, slot, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFeaturedItem(req.Position, req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+
+	if req.Position != nil {
+		item.Position = *req.Position
+	}
+	item.StartsAt, item.EndsAt = req.StartsAt, req.EndsAt
+	if err := f.featuredRepo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (f *featuredUsecase) RemoveItem(ctx context.Context, slot, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	if _, err := f.slotItem(ctx, slot, id); err != nil {
+		return err
+	}
+
+	return f.featuredRepo.Delete(ctx, id)
+}
+
+func (f *featuredUsecase) ReorderSlot(ctx context.Context, slot string, ids []string) ([]*domain.FeaturedItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	if !domain.IsValidFeaturedSlot(slot) {
+		return nil, domain.ErrInvalidFeaturedSlot
+	}
+	if err := f.featuredRepo.Reorder(ctx, slot, ids); err != nil {
+		return nil, err
+	}
+
+	return f.featuredRepo.GetBySlot(ctx, slot)
+}
+
+func (f *featuredUsecase) ResolveSlot(ctx context.Context, slot, lang string) ([]*domain.FeaturedEntry, error) {
+	if !domain.IsValidFeaturedSlot(slot) {
+		return nil, domain.ErrInvalidFeaturedSlot
+	}
+
+	slots, err := f.resolve(ctx, lang, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return slots[slot], nil
+}
+
+func (f *featuredUsecase) ResolveSlots(ctx context.Context, lang string) (map[string][]*domain.FeaturedEntry, error) {
+	return f.resolve(ctx, lang, domain.FeaturedSlots...)
+}
+
+// resolve loads the content of the active entries of the given slots. An
+// entry whose content is unpublished or was deleted is skipped, so the next
+// one moves up.
+func (f *featuredUsecase) resolve(ctx context.Context, lang string, slots ...string) (map[string][]*domain.FeaturedEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	now := time.Now()
+	items, err := f.featuredRepo.GetActive(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make(map[string][]*domain.FeaturedEntry, len(slots))
+	wanted := make(map[string]bool, len(slots))
+	for _, slot := range slots {
+		resolved[slot] = []*domain.FeaturedEntry{}
+		wanted[slot] = true
+	}
+
+	var articles []*domain.Article
+	for _, item := range items {
+		if !wanted[item.Slot] || len(resolved[item.Slot]) >= domain.FeaturedSlotCapacity[item.Slot] {
+			continue
+		}
+
+		entry, err := f.publicContent(ctx, item, now)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			continue
+		}
+		if entry.Article != nil {
+			articles = append(articles, entry.Article)
+		}
+		resolved[item.Slot] = append(resolved[item.Slot], entry)
+	}
+
+	if err := f.translate(ctx, articles, lang); err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// publicContent loads the content of an entry, or returns nil when the
+// public may not see it
+func (f *featuredUsecase) publicContent(ctx context.Context, item *domain.FeaturedItem, now time.Time) (*domain.FeaturedEntry, error) {
+	entry := &domain.FeaturedEntry{Item: item}
+	switch item.ContentType {
+	case domain.FeaturedContentArticle:
+		article, err := f.articleRepo.GetByID(ctx, item.ContentID)
+		if err == domain.ErrArticleNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !article.IsPublic(now) {
+			return nil, nil
+		}
+		entry.Article = article
+	case domain.FeaturedContentProject:
+		proj, err := f.projectRepo.GetByID(ctx, item.ContentID)
+		if err == project.ErrProjectNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if proj.Status != "published" {
+			return nil, nil
+		}
+		entry.Project = proj
+	case domain.FeaturedContentCourse:
+		c, err := f.courseRepo.GetCourseByID(ctx, item.ContentID)
+		if err == course.ErrCourseNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !c.IsPublic(now) {
+			return nil, nil
+		}
+		// Shown as to a visitor who has not enrolled
+		hideLockedLessons(c)
+		entry.Course = c
+	default:
+		return nil, nil
+	}
+
+	return entry, nil
+}
+
+// translate shows the articles in lang where they have a published translation
+func (f *featuredUsecase) translate(ctx context.Context, articles []*domain.Article, lang string) error {
+	if lang == "" || len(articles) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(articles))
+	for i, article := range articles {
+		ids[i] = article.ID
+	}
+	translations, err := f.translationRepo.GetPublished(ctx, ids, lang)
+	if err != nil {
+		return err
+	}
+	for _, article := range articles {
+		if t, ok := translations[article.ID]; ok && article.Language != lang {
+			article.ApplyTranslation(t)
+		}
+	}
+
+	return nil
+}
+
+// slotItem loads an entry and checks that it belongs to slot
+func (f *featuredUsecase) slotItem(ctx context.Context, slot, id string) (*domain.FeaturedItem, error) {
+	if !domain.IsValidFeaturedSlot(slot) {
+		return nil, domain.ErrInvalidFeaturedSlot
+	}
+
+	item, err := f.featuredRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Slot != slot {
+		return nil, domain.ErrFeaturedItemNotFound
+	}
+
+	return item, nil
+}
+
+// contentExists makes sure an entry is created for content that exists
+func (f *featuredUsecase) contentExists(ctx context.Context, contentType, contentID string) error {
+	var err error
+	switch contentType {
+	case domain.FeaturedContentArticle:
+		_, err = f.articleRepo.GetByID(ctx, contentID)
+	case domain.FeaturedContentProject:
+		_, err = f.projectRepo.GetByID(ctx, contentID)
+	case domain.FeaturedContentCourse:
+		_, err = f.courseRepo.GetCourseByID(ctx, contentID)
+	}
+	return err
+}
+
+func validateFeaturedItem(position *int, startsAt, endsAt *time.Time) error {
+	if position != nil && *position < 0 {
+		return domain.ErrInvalidFeaturedPosition
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return domain.ErrInvalidFeaturedWindow
+	}
+	return nil
+}
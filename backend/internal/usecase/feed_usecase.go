@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+type feedUsecase struct {
+	articleUsecase domain.ArticleUsecase
+	categoryRepo   domain.CategoryRepository
+	tagRepo        domain.TagRepository
+	site           domain.Site
+	timeout        time.Duration
+}
+
+func NewFeedUsecase(articleUC domain.ArticleUsecase, categoryRepo domain.CategoryRepository, tagRepo domain.TagRepository, site domain.Site, timeout time.Duration) domain.FeedUsecase {
+	return &feedUsecase{
+		articleUsecase: articleUC,
+		categoryRepo:   categoryRepo,
+		tagRepo:        tagRepo,
+		site:           site,
+		timeout:        timeout,
+	}
+}
+
+func (f *feedUsecase) GetArticleFeed(ctx context.Context, filter domain.FeedFilter) (*domain.Feed, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	feed := &domain.Feed{
+		Title:       f.site.Name,
+		Description: f.site.Description,
+		HomeURL:     f.site.URL,
+	}
+
+	published := true
+	params := domain.ArticleListParams{
+		Page:      1,
+		Limit:     domain.FeedItemLimit,
+		Published: &published,
+	}
+
+	switch {
+	case filter.Category != "":
+		category, err := f.categoryRepo.GetBySlug(ctx, filter.Category)
+		if err != nil {
+			return nil, err
+		}
+		params.Category = category.Slug
+		feed.Title = f.site.Name + " - " + category.Name
+		feed.Description = category.Name + " articles on " + f.site.Name
+	case filter.Tag != "":
+		tag, err := f.tagRepo.GetBySlug(ctx, filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		params.Tags = []string{tag.Slug}
+		feed.Title = f.site.Name + " - #" + tag.Name
+		feed.Description = "Articles tagged " + tag.Name + " on " + f.site.Name
+		if tag.Description != "" {
+			feed.Description = tag.Description
+		}
+	}
+
+	result, err := f.articleUsecase.GetArticles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, article := range result.Articles {
+		html, err := domain.RenderContent(article.Content, domain.ContentFormatHTML)
+		if err != nil {
+			return nil, err
+		}
+
+		// Counters and workflow moves do not change the entry, so only content
+		// edits date it; this keeps the feed body, and so its ETag, stable
+		updated := article.ContentUpdatedAt
+		if updated.Before(article.PublishedAt) {
+			updated = article.PublishedAt
+		}
+		if updated.After(feed.Updated) {
+			feed.Updated = updated
+		}
+
+		feed.Items = append(feed.Items, domain.FeedItem{
+			ID:          "urn:article:" + article.ID, // stays the same when the slug changes
+			Title:       article.Title,
+			URL:         f.site.ArticleURL(article.Slug),
+			Summary:     article.Excerpt,
+			ContentHTML: html,
+			Image:       article.Thumbnail,
+			AuthorName:  article.Author.Name,
+			Categories:  []string{article.Category.Name},
+			Published:   article.PublishedAt,
+			Updated:     updated,
+		})
+	}
+
+	return feed, nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+)
+
+type previewUsecase struct {
+	previewRepo domain.PreviewRepository
+	articleRepo domain.ArticleRepository
+	seriesRepo  domain.SeriesRepository
+	courseRepo  course.Repository
+	timeout     time.Duration
+}
+
+func NewPreviewUsecase(previewRepo domain.PreviewRepository, articleRepo domain.ArticleRepository, seriesRepo domain.SeriesRepository, courseRepo course.Repository, timeout time.Duration) domain.PreviewUsecase {
+	return &previewUsecase{
+		previewRepo: previewRepo,
+		articleRepo: articleRepo,
+		seriesRepo:  seriesRepo,
+		courseRepo:  courseRepo,
+		timeout:     timeout,
+	}
+}
+
+func (p *previewUsecase) CreateToken(ctx context.Context, contentType, contentID string, req domain.CreatePreviewTokenRequest, createdBy string) (*domain.PreviewToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if !domain.IsValidPreviewContentType(contentType) {
+		return nil, domain.ErrInvalidPreviewContentType
+	}
+
+	hours := req.ExpiresInHours
+	if hours == 0 {
+		hours = domain.DefaultPreviewTTLHours
+	}
+	if hours < 1 || hours > domain.MaxPreviewTTLHours {
+		return nil, domain.ErrInvalidPreviewTTL
+	}
+
+	if err := p.contentExists(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	token, tokenHash, err := domain.NewPreviewToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	previewToken := &domain.PreviewToken{
+		ID:          id,
+		ContentType: contentType,
+		ContentID:   contentID,
+		Token:       token,
+		TokenHash:   tokenHash,
+		CreatedBy:   createdBy,
+		ExpiresAt:   now.Add(time.Duration(hours) * time.Hour),
+		CreatedAt:   now,
+	}
+	if err := p.previewRepo.Create(ctx, previewToken); err != nil {
+		return nil, err
+	}
+
+	return previewToken, nil
+}
+
+func (p *previewUsecase) GetTokens(ctx context.Context, contentType, contentID string) ([]*domain.PreviewToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if !domain.IsValidPreviewContentType(contentType) {
+		return nil, domain.ErrInvalidPreviewContentType
+	}
+	if err := p.contentExists(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	return p.previewRepo.GetByContent(ctx, contentType, contentID)
+}
+
+func (p *previewUsecase) RevokeToken(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	return p.previewRepo.Revoke(ctx, id)
+}
+
+func (p *previewUsecase) GetPreview(ctx context.Context, token string) (*domain.Preview, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if token == "" {
+		return nil, domain.ErrPreviewTokenNotFound
+	}
+
+	previewToken, err := p.previewRepo.GetByHash(ctx, domain.HashPreviewToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if previewToken.RevokedAt != nil {
+		return nil, domain.ErrPreviewTokenNotFound
+	}
+	if !previewToken.Active(time.Now()) {
+		return nil, domain.ErrPreviewTokenExpired
+	}
+
+	preview := &domain.Preview{Token: previewToken}
+	switch previewToken.ContentType {
+	case domain.PreviewContentArticle:
+		article, err := p.articleRepo.GetByID(ctx, previewToken.ContentID)
+		if err != nil {
+			return nil, previewContentError(err)
+		}
+		// The series navigation includes the article itself, even while
+		// it is not yet among the published parts
+		series, err := p.seriesRepo.GetByArticle(ctx, article.ID, false)
+		if err != nil && err != domain.ErrSeriesNotFound {
+			return nil, err
+		}
+		if series != nil {
+			article.Series = series.Navigation(article.ID)
+		}
+		preview.Article = article
+	case domain.PreviewContentCourse:
+		c, err := p.courseRepo.GetCourseByID(ctx, previewToken.ContentID)
+		if err != nil {
+			return nil, previewContentError(err)
+		}
+		// Shown as to a visitor who has not enrolled
+		hideLockedLessons(c)
+		preview.Course = c
+	default:
+		return nil, domain.ErrPreviewTokenNotFound
+	}
+
+	return preview, nil
+}
+
+// contentExists checks that a token is created for content that exists
+func (p *previewUsecase) contentExists(ctx context.Context, contentType, contentID string) error {
+	var err error
+	switch contentType {
+	case domain.PreviewContentArticle:
+		_, err = p.articleRepo.GetByID(ctx, contentID)
+	case domain.PreviewContentCourse:
+		_, err = p.courseRepo.GetCourseByID(ctx, contentID)
+	}
+	return err
+}
+
+// previewContentError hides that the content behind a token was deleted
+func previewContentError(err error) error {
+	if err == domain.ErrArticleNotFound || err == course.ErrCourseNotFound {
+		return domain.ErrPreviewTokenNotFound
+	}
+	return err
+}
@@ -3,18 +3,23 @@ package usecase
 import (
 	"context"
 
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/bulk"
+	"portfolio/internal/domain/pagination"
 	"portfolio/internal/domain/project"
 )
 
 // ProjectUseCase implements project business logic
 type ProjectUseCase struct {
-	projectRepo project.Repository
+	projectRepo  project.Repository
+	redirectRepo domain.SlugRedirectRepository
 }
 
 // NewProjectUseCase creates a new project usecase
-func NewProjectUseCase(projectRepo project.Repository) *ProjectUseCase {
+func NewProjectUseCase(projectRepo project.Repository, redirectRepo domain.SlugRedirectRepository) *ProjectUseCase {
 	return &ProjectUseCase{
-		projectRepo: projectRepo,
+		projectRepo:  projectRepo,
+		redirectRepo: redirectRepo,
 	}
 }
 
@@ -48,9 +53,14 @@ func (uc *ProjectUseCase) GetProject(ctx context.Context, id string) (*project.P
 	return uc.projectRepo.GetByID(ctx, id)
 }
 
-// GetProjectBySlug retrieves a project by slug
+// GetProjectBySlug retrieves a project by slug, or returns a
+// *domain.SlugRedirect error for an old slug
 func (uc *ProjectUseCase) GetProjectBySlug(ctx context.Context, slug string) (*project.Project, error) {
-	return uc.projectRepo.GetBySlug(ctx, slug)
+	proj, err := uc.projectRepo.GetBySlug(ctx, slug)
+	if err == project.ErrProjectNotFound {
+		return nil, domain.ResolveSlugRedirect(ctx, uc.redirectRepo, domain.SlugContentProject, slug, err)
+	}
+	return proj, err
 }
 
 // UpdateProject updates a project
@@ -63,7 +73,39 @@ func (uc *ProjectUseCase) DeleteProject(ctx context.Context, id string) error {
 	return uc.projectRepo.Delete(ctx, id)
 }
 
+// BulkProjects applies one action to many projects at once
+func (uc *ProjectUseCase) BulkProjects(ctx context.Context, req bulk.Request) (*bulk.Report, error) {
+	err := req.Normalize(bulk.ActionPublish, bulk.ActionUnpublish, bulk.ActionFeature, bulk.ActionUnfeature,
+		bulk.ActionChangeCategory, bulk.ActionAddTag, bulk.ActionRemoveTag, bulk.ActionDelete)
+	if err != nil {
+		return nil, err
+	}
+	return uc.projectRepo.BulkUpdate(ctx, req)
+}
+
 // ListProjects retrieves all projects with optional filters
 func (uc *ProjectUseCase) ListProjects(ctx context.Context, filters map[string]interface{}) ([]*project.Project, error) {
 	return uc.projectRepo.GetAll(ctx, filters)
 }
+
+// ListProjectsPage retrieves the limit projects after cursor, fetching one
+// more to tell whether there is a next page
+func (uc *ProjectUseCase) ListProjectsPage(ctx context.Context, filters map[string]interface{}, cursor pagination.Cursor, limit int) (*project.ProjectPage, error) {
+	filters["after"] = cursor
+	filters["limit"] = limit + 1
+
+	projects, err := uc.projectRepo.GetAll(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &project.ProjectPage{}
+	if len(projects) > limit {
+		projects = projects[:limit]
+		last := projects[limit-1]
+		page.NextCursor = pagination.Cursor{Order: int64(last.DisplayOrder), Time: last.CreatedAt, ID: last.ID}.Encode()
+	}
+	page.Projects = projects
+
+	return page, nil
+}
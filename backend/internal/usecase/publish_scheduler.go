@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+	"portfolio/internal/infrastructure/logger"
+)
+
+// PublishScheduler flips article and course visibility when their
+// publish_at / unpublish_at times pass. The schedule lives in the database,
+// so a restarted server catches up on its first tick.
+type PublishScheduler struct {
+	articleRepo domain.ArticleRepository
+	courseRepo  course.Repository
+	logger      logger.Logger
+	interval    time.Duration
+}
+
+// NewPublishScheduler creates a new PublishScheduler instance
+func NewPublishScheduler(articleRepo domain.ArticleRepository, courseRepo course.Repository, logger logger.Logger, interval time.Duration) *PublishScheduler {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	return &PublishScheduler{
+		articleRepo: articleRepo,
+		courseRepo:  courseRepo,
+		logger:      logger,
+		interval:    interval,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled
+func (s *PublishScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.RunOnce(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.RunOnce(ctx)
+			}
+		}
+	}()
+}
+
+// RunOnce applies every schedule entry that is due
+func (s *PublishScheduler) RunOnce(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	now := time.Now()
+
+	published, unpublished, err := s.articleRepo.ApplyPublishSchedule(ctx, now)
+	if err != nil {
+		s.logger.Error("Failed to apply article publish schedule", err)
+	} else if published > 0 || unpublished > 0 {
+		s.logger.Info(fmt.Sprintf("Article schedule applied: %d published, %d unpublished", published, unpublished))
+	}
+
+	published, unpublished, err = s.courseRepo.ApplyPublishSchedule(ctx, now)
+	if err != nil {
+		s.logger.Error("Failed to apply course publish schedule", err)
+	} else if published > 0 || unpublished > 0 {
+		s.logger.Info(fmt.Sprintf("Course schedule applied: %d published, %d unpublished", published, unpublished))
+	}
+}
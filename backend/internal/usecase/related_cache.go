@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+// relatedCache keeps computed related-article lists per article ID. Any
+// article change can move scores across the whole corpus, so writes clear
+// the cache entirely; the TTL covers visibility flips made by the publish
+// scheduler, which bypasses the usecase.
+type relatedCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]relatedCacheEntry
+}
+
+type relatedCacheEntry struct {
+	related   []domain.RelatedArticle
+	expiresAt time.Time
+}
+
+func newRelatedCache(ttl time.Duration) *relatedCache {
+	return &relatedCache{
+		ttl:     ttl,
+		entries: make(map[string]relatedCacheEntry),
+	}
+}
+
+func (c *relatedCache) get(articleID string) ([]domain.RelatedArticle, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[articleID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.related, true
+}
+
+func (c *relatedCache) set(articleID string, related []domain.RelatedArticle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[articleID] = relatedCacheEntry{related: related, expiresAt: time.Now().Add(c.ttl)}
+}
+
+func (c *relatedCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]relatedCacheEntry)
+}
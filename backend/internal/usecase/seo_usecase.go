@@ -0,0 +1,450 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"portfolio/internal/domain"
+	"portfolio/internal/domain/course"
+	"portfolio/internal/domain/project"
+)
+
+type seoUsecase struct {
+	seoRepo     domain.SEORepository
+	articleRepo domain.ArticleRepository
+	projectRepo project.Repository
+	courseRepo  course.Repository
+	site        domain.Site
+	timeout     time.Duration
+}
+
+func NewSEOUsecase(seoRepo domain.SEORepository, articleRepo domain.ArticleRepository, projectRepo project.Repository, courseRepo course.Repository, site domain.Site, timeout time.Duration) domain.SEOUsecase {
+	return &seoUsecase{
+		seoRepo:     seoRepo,
+		articleRepo: articleRepo,
+		projectRepo: projectRepo,
+		courseRepo:  courseRepo,
+		site:        site,
+		timeout:     timeout,
+	}
+}
+
+func (s *seoUsecase) GetMetadata(ctx context.Context, rawPath string) (*domain.SEOMetadata, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	sitePath, ok := cleanSEOPath(rawPath)
+	if !ok {
+		return nil, domain.ErrSEOPageNotFound
+	}
+
+	segments := strings.Split(strings.Trim(sitePath, "/"), "/")
+	if len(segments) == 2 {
+		slug, err := url.PathUnescape(segments[1])
+		if err != nil {
+			return nil, domain.ErrSEOPageNotFound
+		}
+
+		switch segments[0] {
+		case "articles":
+			return s.articleMetadata(ctx, sitePath, slug)
+		case "projects":
+			return s.projectMetadata(ctx, sitePath, slug)
+		case "courses":
+			return s.courseMetadata(ctx, sitePath, slug)
+		}
+	}
+
+	return s.pageMetadata(sitePath)
+}
+
+func (s *seoUsecase) articleMetadata(ctx context.Context, sitePath, slug string) (*domain.SEOMetadata, error) {
+	article, err := s.articleRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == domain.ErrArticleNotFound {
+			return nil, domain.ErrSEOPageNotFound
+		}
+		return nil, err
+	}
+
+	description := article.Excerpt
+	if strings.TrimSpace(description) == "" {
+		description = domain.ParseEditorJS(article.Content).PlainText()
+	}
+
+	published, modified := article.PublishedAt, article.ContentUpdatedAt
+	if modified.Before(published) {
+		modified = published
+	}
+	meta := s.newMetadata(sitePath, article.Title, description, article.Thumbnail)
+	meta.ContentType, meta.ContentID = domain.SEOContentArticle, article.ID
+	meta.CanonicalURL = s.site.ArticleURL(article.Slug)
+	meta.PublishedTime, meta.ModifiedTime = &published, &modified
+	meta.OpenGraph.Type = "article"
+	meta.OpenGraph.Author = article.Author.Name
+	meta.OpenGraph.Section = article.Category.Name
+	meta.OpenGraph.Tags = article.Tags
+
+	if err := s.applyOverride(ctx, meta); err != nil {
+		return nil, err
+	}
+
+	author := map[string]interface{}{"@type": "Person", "name": article.Author.Name}
+	if article.Author.Slug != "" {
+		author["url"] = s.site.AuthorURL(article.Author.Slug)
+	}
+
+	meta.JSONLD = map[string]interface{}{
+		"@context":         "https://schema.org",
+		"@type":            "BlogPosting",
+		"headline":         meta.Title,
+		"description":      meta.Description,
+		"image":            meta.Image,
+		"url":              meta.CanonicalURL,
+		"mainEntityOfPage": map[string]interface{}{"@type": "WebPage", "@id": meta.CanonicalURL},
+		"datePublished":    published.UTC().Format(time.RFC3339),
+		"dateModified":     modified.UTC().Format(time.RFC3339),
+		"author":           author,
+		"publisher":        s.sitePerson(),
+		"articleSection":   article.Category.Name,
+		"keywords":         strings.Join(article.Tags, ", "),
+		"inLanguage":       article.Language,
+	}
+	if article.ReadTime > 0 {
+		meta.JSONLD["timeRequired"] = readTimeDuration(article.ReadTime)
+	}
+	s.finish(meta)
+
+	return meta, nil
+}
+
+func (s *seoUsecase) projectMetadata(ctx context.Context, sitePath, slug string) (*domain.SEOMetadata, error) {
+	proj, err := s.projectRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == project.ErrProjectNotFound {
+			return nil, domain.ErrSEOPageNotFound
+		}
+		return nil, err
+	}
+
+	description := proj.ShortDescription
+	if strings.TrimSpace(description) == "" {
+		description = proj.Description
+	}
+
+	created, modified := proj.CreatedAt, proj.UpdatedAt
+	meta := s.newMetadata(sitePath, proj.Title, description, proj.ThumbnailURL)
+	meta.ContentType, meta.ContentID = domain.SEOContentProject, proj.ID
+	meta.CanonicalURL = s.site.ProjectURL(proj.Slug)
+	meta.PublishedTime, meta.ModifiedTime = &created, &modified
+	meta.OpenGraph.Tags = proj.Technologies
+
+	if err := s.applyOverride(ctx, meta); err != nil {
+		return nil, err
+	}
+
+	jsonLD := map[string]interface{}{
+		"@context":     "https://schema.org",
+		"@type":        "CreativeWork",
+		"name":         meta.Title,
+		"description":  meta.Description,
+		"image":        meta.Image,
+		"url":          meta.CanonicalURL,
+		"creator":      s.sitePerson(),
+		"keywords":     strings.Join(proj.Technologies, ", "),
+		"dateCreated":  created.UTC().Format(time.RFC3339),
+		"dateModified": modified.UTC().Format(time.RFC3339),
+	}
+	if proj.ProjectURL != "" {
+		jsonLD["sameAs"] = proj.ProjectURL
+	}
+	if proj.GithubURL != "" {
+		jsonLD["codeRepository"] = proj.GithubURL
+	}
+	meta.JSONLD = jsonLD
+	s.finish(meta)
+
+	return meta, nil
+}
+
+func (s *seoUsecase) courseMetadata(ctx context.Context, sitePath, slug string) (*domain.SEOMetadata, error) {
+	c, err := s.courseRepo.GetCourseBySlug(ctx, slug)
+	if err != nil {
+		if err == course.ErrCourseNotFound {
+			return nil, domain.ErrSEOPageNotFound
+		}
+		return nil, err
+	}
+	if !c.IsPublic(time.Now()) {
+		return nil, domain.ErrSEOPageNotFound
+	}
+
+	created, modified := c.CreatedAt, c.UpdatedAt
+	meta := s.newMetadata(sitePath, c.Title, c.Description, c.Thumbnail)
+	meta.ContentType, meta.ContentID = domain.SEOContentCourse, c.ID
+	meta.CanonicalURL = s.site.CourseURL(c.Slug)
+	meta.PublishedTime, meta.ModifiedTime = &created, &modified
+
+	if err := s.applyOverride(ctx, meta); err != nil {
+		return nil, err
+	}
+
+	jsonLD := map[string]interface{}{
+		"@context":            "https://schema.org",
+		"@type":               "Course",
+		"name":                meta.Title,
+		"description":         meta.Description,
+		"image":               meta.Image,
+		"url":                 meta.CanonicalURL,
+		"provider":            s.sitePerson(),
+		"isAccessibleForFree": c.IsFree,
+		"dateCreated":         created.UTC().Format(time.RFC3339),
+		"dateModified":        modified.UTC().Format(time.RFC3339),
+	}
+	if c.Level != "" {
+		jsonLD["educationalLevel"] = c.Level
+	}
+	if c.TotalDuration > 0 {
+		jsonLD["timeRequired"] = readTimeDuration((c.TotalDuration + 59) / 60)
+	}
+	meta.JSONLD = jsonLD
+	s.finish(meta)
+
+	return meta, nil
+}
+
+// pageMetadata describes the static pages of the site
+func (s *seoUsecase) pageMetadata(sitePath string) (*domain.SEOMetadata, error) {
+	var title, schemaType string
+	switch sitePath {
+	case "/":
+		title, schemaType = s.site.Name, "WebSite"
+	case "/projects":
+		title, schemaType = "Projects - "+s.site.Name, "CollectionPage"
+	case "/about":
+		title, schemaType = "About - "+s.site.Name, "ProfilePage"
+	default:
+		return nil, domain.ErrSEOPageNotFound
+	}
+
+	meta := s.newMetadata(sitePath, title, s.site.Description, "")
+	meta.JSONLD = map[string]interface{}{
+		"@context":    "https://schema.org",
+		"@type":       schemaType,
+		"name":        meta.Title,
+		"description": meta.Description,
+		"url":         meta.CanonicalURL,
+	}
+	if schemaType == "ProfilePage" {
+		meta.JSONLD["mainEntity"] = s.sitePerson()
+	} else {
+		meta.JSONLD["publisher"] = s.sitePerson()
+	}
+	s.finish(meta)
+
+	return meta, nil
+}
+
+func (s *seoUsecase) newMetadata(sitePath, title, description, image string) *domain.SEOMetadata {
+	return &domain.SEOMetadata{
+		Path:         sitePath,
+		CanonicalURL: s.site.Link(sitePath),
+		Title:        title,
+		Description:  summarizeForSEO(description),
+		Image:        image,
+		OpenGraph:    domain.OpenGraphMetadata{Type: "website"},
+	}
+}
+
+// applyOverride replaces generated fields with the admin override, if any
+func (s *seoUsecase) applyOverride(ctx context.Context, meta *domain.SEOMetadata) error {
+	override, err := s.seoRepo.GetOverride(ctx, meta.ContentType, meta.ContentID)
+	if err != nil {
+		if err == domain.ErrSEOOverrideNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if override.Title != "" {
+		meta.Title = override.Title
+		meta.Overridden = append(meta.Overridden, "title")
+	}
+	if override.Description != "" {
+		meta.Description = override.Description
+		meta.Overridden = append(meta.Overridden, "description")
+	}
+	if override.Image != "" {
+		meta.Image = override.Image
+		meta.Overridden = append(meta.Overridden, "image")
+	}
+	if override.CanonicalURL != "" {
+		meta.CanonicalURL = override.CanonicalURL
+		meta.Overridden = append(meta.Overridden, "canonicalUrl")
+	}
+	if override.NoIndex {
+		meta.NoIndex = true
+		meta.Overridden = append(meta.Overridden, "noIndex")
+	}
+
+	return nil
+}
+
+// finish resolves the image and fills the OpenGraph and Twitter fields from
+// the final title, description and image
+func (s *seoUsecase) finish(meta *domain.SEOMetadata) {
+	if meta.Image == "" {
+		meta.Image = s.site.DefaultImage
+	}
+	meta.Image = s.site.AbsoluteURL(meta.Image)
+	if meta.JSONLD != nil && meta.JSONLD["image"] != nil {
+		meta.JSONLD["image"] = meta.Image
+	}
+
+	meta.OpenGraph.URL = meta.CanonicalURL
+	meta.OpenGraph.Title = meta.Title
+	meta.OpenGraph.Description = meta.Description
+	meta.OpenGraph.Image = meta.Image
+	meta.OpenGraph.SiteName = s.site.Name
+
+	card := "summary_large_image"
+	if meta.Image == "" {
+		card = "summary"
+	}
+	meta.Twitter = domain.TwitterCardMetadata{
+		Card:        card,
+		Title:       meta.Title,
+		Description: meta.Description,
+		Image:       meta.Image,
+		Site:        s.site.TwitterHandle,
+		Creator:     s.site.TwitterHandle,
+	}
+}
+
+func (s *seoUsecase) sitePerson() map[string]interface{} {
+	return map[string]interface{}{
+		"@type": "Person",
+		"name":  s.site.Author,
+		"url":   s.site.URL,
+	}
+}
+
+func (s *seoUsecase) GetOverride(ctx context.Context, contentType, contentID string) (*domain.SEOOverride, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.checkContent(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	return s.seoRepo.GetOverride(ctx, contentType, contentID)
+}
+
+func (s *seoUsecase) SetOverride(ctx context.Context, contentType, contentID string, req domain.SEOOverrideRequest) (*domain.SEOOverride, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.checkContent(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	canonical := strings.TrimSpace(req.CanonicalURL)
+	if canonical != "" {
+		parsed, err := url.Parse(canonical)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, domain.ErrInvalidSEOCanonicalURL
+		}
+	}
+
+	override := &domain.SEOOverride{
+		ContentType:  contentType,
+		ContentID:    contentID,
+		Title:        strings.TrimSpace(req.Title),
+		Description:  strings.TrimSpace(req.Description),
+		Image:        strings.TrimSpace(req.Image),
+		CanonicalURL: canonical,
+		NoIndex:      req.NoIndex,
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := s.seoRepo.UpsertOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+func (s *seoUsecase) DeleteOverride(ctx context.Context, contentType, contentID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if !domain.IsValidSEOContentType(contentType) {
+		return domain.ErrInvalidSEOContentType
+	}
+
+	return s.seoRepo.DeleteOverride(ctx, contentType, contentID)
+}
+
+// checkContent makes sure the item an override is for exists
+func (s *seoUsecase) checkContent(ctx context.Context, contentType, contentID string) error {
+	var err error
+	switch contentType {
+	case domain.SEOContentArticle:
+		_, err = s.articleRepo.GetByID(ctx, contentID)
+	case domain.SEOContentProject:
+		_, err = s.projectRepo.GetByID(ctx, contentID)
+	case domain.SEOContentCourse:
+		_, err = s.courseRepo.GetCourseByID(ctx, contentID)
+	default:
+		return domain.ErrInvalidSEOContentType
+	}
+	return err
+}
+
+// cleanSEOPath reduces a path or full URL to a clean site path
+func cleanSEOPath(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+
+	p := parsed.EscapedPath()
+	if p == "" {
+		p = "/"
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return path.Clean(p), true
+}
+
+// summarizeForSEO collapses whitespace and cuts text at a word boundary to
+// at most SEODescriptionLength characters
+func summarizeForSEO(text string) string {
+	text = strings.Join(strings.Fields(domain.StripHTML(text)), " ")
+	if utf8.RuneCountInString(text) <= domain.SEODescriptionLength {
+		return text
+	}
+
+	runes := []rune(text)
+	cut := string(runes[:domain.SEODescriptionLength-1])
+	if i := strings.LastIndex(cut, " "); i > domain.SEODescriptionLength/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:") + "…"
+}
+
+// readTimeDuration formats minutes as an ISO 8601 duration
+func readTimeDuration(minutes int) string {
+	return fmt.Sprintf("PT%dM", minutes)
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+type seriesUsecase struct {
+	seriesRepo  domain.SeriesRepository
+	articleRepo domain.ArticleRepository
+	timeout     time.Duration
+}
+
+func NewSeriesUsecase(seriesRepo domain.SeriesRepository, articleRepo domain.ArticleRepository, timeout time.Duration) domain.SeriesUsecase {
+	return &seriesUsecase{
+		seriesRepo:  seriesRepo,
+		articleRepo: articleRepo,
+		timeout:     timeout,
+	}
+}
+
+func (s *seriesUsecase) GetSeriesList(ctx context.Context, publicOnly bool) ([]*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.seriesRepo.GetAll(ctx, publicOnly)
+}
+
+func (s *seriesUsecase) GetSeriesByID(ctx context.Context, id string) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.seriesRepo.GetByID(ctx, id, false)
+}
+
+func (s *seriesUsecase) GetPublicSeries(ctx context.Context, slug string) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	series, err := s.seriesRepo.GetBySlug(ctx, slug, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// A series with no published parts doesn't exist for readers yet
+	if len(series.Articles) == 0 {
+		return nil, domain.ErrSeriesNotFound
+	}
+
+	return series, nil
+}
+
+func (s *seriesUsecase) CreateSeries(ctx context.Context, req domain.CreateSeriesRequest) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	id, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate series ID: %w", err)
+	}
+
+	series := &domain.Series{
+		ID:          id,
+		Title:       strings.TrimSpace(req.Title),
+		Description: strings.TrimSpace(req.Description),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.assignSlug(ctx, series); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateArticles(ctx, req.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.seriesRepo.Create(ctx, series, req.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	return s.seriesRepo.GetByID(ctx, id, false)
+}
+
+func (s *seriesUsecase) UpdateSeries(ctx context.Context, id string, req domain.UpdateSeriesRequest) (*domain.Series, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	series, err := s.seriesRepo.GetByID(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if title := strings.TrimSpace(req.Title); title != "" && title != series.Title {
+		series.Title = title
+		if err := s.assignSlug(ctx, series); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Description != nil {
+		series.Description = strings.TrimSpace(*req.Description)
+	}
+
+	if req.ArticleIDs != nil {
+		if err := s.validateArticles(ctx, *req.ArticleIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.seriesRepo.Update(ctx, series, req.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	return s.seriesRepo.GetByID(ctx, id, false)
+}
+
+func (s *seriesUsecase) DeleteSeries(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// Parts stay as standalone articles
+	return s.seriesRepo.Delete(ctx, id)
+}
+
+// assignSlug derives the slug from the title and rejects one used by another series
+func (s *seriesUsecase) assignSlug(ctx context.Context, series *domain.Series) error {
+	slug := domain.Slugify(series.Title)
+	if slug == "" {
+		return domain.ErrInvalidSeriesTitle
+	}
+
+	existing, err := s.seriesRepo.GetBySlug(ctx, slug, false)
+	if err != nil && err != domain.ErrSeriesNotFound {
+		return err
+	}
+	if existing != nil && existing.ID != series.ID {
+		return domain.ErrSlugAlreadyExists
+	}
+
+	series.Slug = slug
+	return nil
+}
+
+func (s *seriesUsecase) validateArticles(ctx context.Context, articleIDs []string) error {
+	seen := make(map[string]bool, len(articleIDs))
+	for _, id := range articleIDs {
+		if seen[id] {
+			return domain.ErrInvalidSeriesArticles
+		}
+		seen[id] = true
+
+		if _, err := s.articleRepo.GetByID(ctx, id); err != nil {
+			if err == domain.ErrArticleNotFound {
+				return domain.ErrInvalidSeriesArticles
+			}
+			return err
+		}
+	}
+	return nil
+}
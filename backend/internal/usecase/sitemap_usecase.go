@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+// sitemapStaticPages are the site pages that are not backed by content
+var sitemapStaticPages = []string{"/", "/projects", "/about"}
+
+type sitemapUsecase struct {
+	sitemapRepo domain.SitemapRepository
+	site        domain.Site
+	timeout     time.Duration
+}
+
+func NewSitemapUsecase(sitemapRepo domain.SitemapRepository, site domain.Site, timeout time.Duration) domain.SitemapUsecase {
+	return &sitemapUsecase{
+		sitemapRepo: sitemapRepo,
+		site:        site,
+		timeout:     timeout,
+	}
+}
+
+func (s *sitemapUsecase) GetSitemapIndex(ctx context.Context) ([]domain.SitemapFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var files []domain.SitemapFile
+	for _, section := range domain.SitemapSections {
+		urls, err := s.sectionURLs(ctx, section)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, chunk := range domain.SplitSitemapURLs(urls) {
+			name := fmt.Sprintf("%s-%d.xml", section, i+1)
+			files = append(files, domain.SitemapFile{
+				Name:    name,
+				Loc:     s.site.Link("/sitemaps/" + name),
+				LastMod: latestSitemapMod(chunk),
+			})
+		}
+	}
+
+	return files, nil
+}
+
+func (s *sitemapUsecase) GetSitemap(ctx context.Context, section string, page int) ([]domain.SitemapURL, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	urls, err := s.sectionURLs(ctx, section)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := domain.SplitSitemapURLs(urls)
+	if page < 1 || page > len(chunks) {
+		return nil, domain.ErrSitemapNotFound
+	}
+
+	return chunks[page-1], nil
+}
+
+func (s *sitemapUsecase) GetRobotsTxt() string {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	b.WriteString("Allow: /\n")
+	b.WriteString("Disallow: /admin/\n")
+	b.WriteString("Disallow: /api/\n")
+	b.WriteString("\n")
+	b.WriteString("Sitemap: " + s.site.Link("/sitemap.xml") + "\n")
+	return b.String()
+}
+
+func (s *sitemapUsecase) sectionURLs(ctx context.Context, section string) ([]domain.SitemapURL, error) {
+	var entries []domain.SitemapEntry
+	var toURL func(slug string) string
+	var err error
+
+	switch section {
+	case domain.SitemapPages:
+		return s.pageURLs(ctx)
+	case domain.SitemapArticles:
+		entries, err = s.sitemapRepo.GetArticleEntries(ctx)
+		toURL = s.site.ArticleURL
+	case domain.SitemapProjects:
+		entries, err = s.sitemapRepo.GetProjectEntries(ctx)
+		toURL = s.site.ProjectURL
+	case domain.SitemapCourses:
+		entries, err = s.sitemapRepo.GetCourseEntries(ctx)
+		toURL = s.site.CourseURL
+	case domain.SitemapCategories:
+		entries, err = s.sitemapRepo.GetCategoryEntries(ctx)
+		toURL = s.site.CategoryURL
+	default:
+		return nil, domain.ErrSitemapNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]domain.SitemapURL, len(entries))
+	for i, entry := range entries {
+		urls[i] = domain.SitemapURL{Loc: toURL(entry.Slug), LastMod: entry.LastMod}
+	}
+	return urls, nil
+}
+
+// pageURLs dates the home page by the latest article and the projects page
+// by the latest project; other static pages carry no lastmod
+func (s *sitemapUsecase) pageURLs(ctx context.Context) ([]domain.SitemapURL, error) {
+	articles, err := s.sitemapRepo.GetArticleEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.sitemapRepo.GetProjectEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastMods := map[string]time.Time{
+		"/":         latestEntryMod(articles),
+		"/projects": latestEntryMod(projects),
+	}
+
+	urls := make([]domain.SitemapURL, len(sitemapStaticPages))
+	for i, path := range sitemapStaticPages {
+		urls[i] = domain.SitemapURL{Loc: s.site.Link(path), LastMod: lastMods[path]}
+	}
+	return urls, nil
+}
+
+func latestEntryMod(entries []domain.SitemapEntry) time.Time {
+	var latest time.Time
+	for _, entry := range entries {
+		if entry.LastMod.After(latest) {
+			latest = entry.LastMod
+		}
+	}
+	return latest
+}
+
+func latestSitemapMod(urls []domain.SitemapURL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
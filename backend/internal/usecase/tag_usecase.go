@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"portfolio/internal/domain"
+)
+
+type tagUsecase struct {
+	tagRepo     domain.TagRepository
+	articleRepo domain.ArticleRepository
+	timeout     time.Duration
+}
+
+func NewTagUsecase(tagRepo domain.TagRepository, articleRepo domain.ArticleRepository, timeout time.Duration) domain.TagUsecase {
+	return &tagUsecase{
+		tagRepo:     tagRepo,
+		articleRepo: articleRepo,
+		timeout:     timeout,
+	}
+}
+
+func (t *tagUsecase) GetTags(ctx context.Context, publicOnly bool) ([]*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	return t.tagRepo.GetAll(ctx, publicOnly)
+}
+
+func (t *tagUsecase) GetTagBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	if slug == "" {
+		return nil, domain.ErrTagNotFound
+	}
+
+	return t.tagRepo.GetBySlug(ctx, slug)
+}
+
+func (t *tagUsecase) GetTagArticles(ctx context.Context, slug string, page, limit int) (*domain.Tag, *domain.ArticleListResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	tag, err := t.tagRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := true
+	result, err := t.articleRepo.GetAll(ctx, domain.ArticleListParams{
+		Page:      page,
+		Limit:     limit,
+		Tags:      []string{tag.Slug},
+		Published: &published,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, result, nil
+}
+
+func (t *tagUsecase) UpdateTag(ctx context.Context, id string, req domain.UpdateTagRequest) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	tag, err := t.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		slug := domain.Slugify(name)
+		if slug == "" {
+			return nil, domain.ErrInvalidTagName
+		}
+
+		// Renaming onto another tag's slug would silently merge them
+		if slug != tag.Slug {
+			existing, err := t.tagRepo.GetBySlug(ctx, slug)
+			if err != nil && err != domain.ErrTagNotFound {
+				return nil, err
+			}
+			if existing != nil {
+				return nil, domain.ErrTagAlreadyExists
+			}
+		}
+
+		tag.Name = name
+		tag.Slug = slug
+	}
+
+	if req.Description != nil {
+		tag.Description = strings.TrimSpace(*req.Description)
+	}
+
+	if err := t.tagRepo.Update(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return t.tagRepo.GetByID(ctx, id)
+}
+
+func (t *tagUsecase) MergeTags(ctx context.Context, req domain.MergeTagsRequest) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	if _, err := t.tagRepo.GetByID(ctx, req.TargetID); err != nil {
+		return nil, err
+	}
+
+	for _, id := range req.SourceIDs {
+		if id == req.TargetID {
+			return nil, domain.ErrInvalidTagMerge
+		}
+		if _, err := t.tagRepo.GetByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := t.tagRepo.Merge(ctx, req.SourceIDs, req.TargetID); err != nil {
+		return nil, err
+	}
+
+	return t.tagRepo.GetByID(ctx, req.TargetID)
+}
+
+func (t *tagUsecase) DeleteTag(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	return t.tagRepo.Delete(ctx, id)
+}
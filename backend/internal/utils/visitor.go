@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+)
+
+// GetVisitorSalt returns the salt used to hash anonymous visitor fingerprints.
+// Falls back to the JWT secret so hashes are never computed unsalted.
+func GetVisitorSalt() string {
+	if salt := os.Getenv("VISITOR_HASH_SALT"); salt != "" {
+		return salt
+	}
+	return GetJWTSecret()
+}
+
+// HashVisitor builds an anonymous visitor fingerprint from request details.
+// Raw IPs and user agents are never stored, only this salted hash.
+func HashVisitor(parts ...string) string {
+	sum := sha256.Sum256([]byte(GetVisitorSalt() + "|" + strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])
+}
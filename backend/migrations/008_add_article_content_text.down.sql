@@ -0,0 +1,5 @@
+-- Restore the original full-text search index over the raw content
+DROP INDEX IF EXISTS idx_articles_content_search;
+CREATE INDEX IF NOT EXISTS idx_articles_content_search ON articles USING gin(to_tsvector('english', title || ' ' || excerpt || ' ' || COALESCE(content, '')));
+
+ALTER TABLE articles DROP COLUMN IF EXISTS content_text;
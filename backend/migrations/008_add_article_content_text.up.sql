@@ -0,0 +1,47 @@
+-- Store a plain-text rendering of the EditorJS content for search and read time
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_text TEXT NOT NULL DEFAULT '';
+
+-- Text of a list block's items, nested items included, one per line. Items
+-- are plain strings or {content, items} objects. Only needed for the backfill.
+CREATE OR REPLACE FUNCTION pg_temp.list_item_text(items JSONB) RETURNS TEXT AS $$
+BEGIN
+    RETURN (
+        SELECT string_agg(
+            CASE WHEN jsonb_typeof(item) = 'string' THEN item #>> '{}'
+                 ELSE concat_ws(E'\n', item->>'content', pg_temp.list_item_text(item->'items'))
+            END, E'\n')
+        FROM jsonb_array_elements(COALESCE(items, '[]'::jsonb)) item
+    );
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Backfill from the EditorJS blocks; rows with non-JSON content keep their raw text.
+-- The backfill is not an edit, so updated_at is left alone.
+ALTER TABLE articles DISABLE TRIGGER update_articles_updated_at;
+
+DO $$
+DECLARE
+    r RECORD;
+BEGIN
+    FOR r IN SELECT id, content FROM articles WHERE content IS NOT NULL LOOP
+        BEGIN
+            UPDATE articles SET content_text = COALESCE((
+                SELECT string_agg(regexp_replace(
+                    CASE WHEN b->>'type' = 'list' THEN COALESCE(pg_temp.list_item_text(b->'data'->'items'), '')
+                         ELSE COALESCE(b->'data'->>'text', b->'data'->>'code', b->'data'->>'caption', '')
+                    END,
+                    '<[^>]*>', '', 'g'), E'\n')
+                FROM jsonb_array_elements(r.content::jsonb->'blocks') b
+            ), '')
+            WHERE id = r.id;
+        EXCEPTION WHEN others THEN
+            UPDATE articles SET content_text = r.content WHERE id = r.id;
+        END;
+    END LOOP;
+END $$;
+
+ALTER TABLE articles ENABLE TRIGGER update_articles_updated_at;
+
+-- Rebuild the full-text search index over the plain text instead of the raw JSON
+DROP INDEX IF EXISTS idx_articles_content_search;
+CREATE INDEX IF NOT EXISTS idx_articles_content_search ON articles USING gin(to_tsvector('english', title || ' ' || excerpt || ' ' || content_text));
@@ -0,0 +1,25 @@
+-- Article revision history: one snapshot per create and update
+CREATE TABLE IF NOT EXISTS article_revisions (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    excerpt TEXT NOT NULL,
+    content TEXT,
+    category_id VARCHAR(255) NOT NULL,
+    tags JSONB NOT NULL DEFAULT '[]'::jsonb,
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(article_id, revision_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_revisions_article_id ON article_revisions(article_id, revision_number DESC);
+
+-- Seed an initial revision for existing articles so they can be restored
+INSERT INTO article_revisions (id, article_id, revision_number, title, excerpt, content, category_id, tags, author_id, created_at)
+SELECT
+    md5(a.id || '-rev-1'), a.id, 1, a.title, a.excerpt, a.content, a.category_id,
+    COALESCE((SELECT jsonb_agg(t.name ORDER BY t.name) FROM article_tags t WHERE t.article_id = a.id), '[]'::jsonb),
+    a.author_id, a.updated_at
+FROM articles a
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_courses_unpublish_at;
+DROP INDEX IF EXISTS idx_courses_publish_at;
+DROP INDEX IF EXISTS idx_articles_unpublish_at;
+DROP INDEX IF EXISTS idx_articles_publish_at;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE courses DROP COLUMN IF EXISTS publish_at;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE articles DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,12 @@
+-- Scheduled publishing for articles and courses
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMP WITH TIME ZONE;
+
+-- Partial indexes keep the scheduler's due-item scans cheap
+CREATE INDEX IF NOT EXISTS idx_articles_publish_at ON articles(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_articles_unpublish_at ON articles(unpublish_at) WHERE unpublish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_courses_publish_at ON courses(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_courses_unpublish_at ON courses(unpublish_at) WHERE unpublish_at IS NOT NULL;
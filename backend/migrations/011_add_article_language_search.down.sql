@@ -0,0 +1,9 @@
+CREATE INDEX IF NOT EXISTS idx_articles_content_search ON articles USING gin(to_tsvector('english', title || ' ' || excerpt || ' ' || content_text));
+
+DROP INDEX IF EXISTS idx_articles_language;
+DROP INDEX IF EXISTS idx_articles_search_id;
+DROP INDEX IF EXISTS idx_articles_search_en;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS search_vector_id;
+ALTER TABLE articles DROP COLUMN IF EXISTS search_vector_en;
+ALTER TABLE articles DROP COLUMN IF EXISTS language;
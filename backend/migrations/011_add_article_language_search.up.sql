@@ -0,0 +1,36 @@
+-- Per-article language and language-specific full-text search vectors
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS language VARCHAR(5) NOT NULL DEFAULT 'en'
+    CHECK (language IN ('en', 'id'));
+
+-- Guess the language of existing articles the same way the API does:
+-- count common English and Indonesian function words
+UPDATE articles SET language = 'id'
+WHERE (
+    SELECT COUNT(*) FROM regexp_split_to_table(LOWER(title || ' ' || content_text), '[^[:alpha:]]+') w
+    WHERE w IN ('yang', 'dan', 'di', 'ini', 'itu', 'dengan', 'untuk', 'dari', 'dalam',
+                'tidak', 'akan', 'pada', 'adalah', 'juga', 'ke', 'bisa', 'cara', 'kita')
+) > (
+    SELECT COUNT(*) FROM regexp_split_to_table(LOWER(title || ' ' || content_text), '[^[:alpha:]]+') w
+    WHERE w IN ('the', 'and', 'of', 'to', 'is', 'in', 'that', 'for', 'with',
+                'this', 'it', 'on', 'are', 'be', 'as', 'was', 'you', 'how')
+);
+
+-- Weighted vectors: title A, excerpt B, body text C
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector_en tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('english', excerpt), 'B') ||
+    setweight(to_tsvector('english', content_text), 'C')
+) STORED;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector_id tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('indonesian', title), 'A') ||
+    setweight(to_tsvector('indonesian', excerpt), 'B') ||
+    setweight(to_tsvector('indonesian', content_text), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_articles_search_en ON articles USING gin(search_vector_en);
+CREATE INDEX IF NOT EXISTS idx_articles_search_id ON articles USING gin(search_vector_id);
+CREATE INDEX IF NOT EXISTS idx_articles_language ON articles(language);
+
+-- Superseded by the generated vectors
+DROP INDEX IF EXISTS idx_articles_content_search;
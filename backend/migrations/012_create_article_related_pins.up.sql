@@ -0,0 +1,11 @@
+-- Admin-pinned related articles, shown ahead of computed suggestions
+CREATE TABLE IF NOT EXISTS article_related_pins (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    related_article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, related_article_id),
+    CHECK (article_id <> related_article_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_related_pins_position ON article_related_pins(article_id, position);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_article_tags_tag_id;
+ALTER TABLE article_tags DROP CONSTRAINT IF EXISTS article_tags_article_id_tag_id_key;
+ALTER TABLE article_tags DROP COLUMN IF EXISTS tag_id;
+
+DROP TABLE IF EXISTS tags;
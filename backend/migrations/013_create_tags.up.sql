@@ -0,0 +1,86 @@
+-- Canonical tags; article_tags rows point at one and copy its name
+CREATE TABLE IF NOT EXISTS tags (
+    id VARCHAR(255) PRIMARY KEY DEFAULT md5(random()::text || clock_timestamp()::text),
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE article_tags ADD COLUMN IF NOT EXISTS tag_id VARCHAR(255) REFERENCES tags(id) ON DELETE CASCADE;
+
+-- Same rules as domain.Slugify for ASCII names ("c#" is c-sharp, "c++" is
+-- cpp); letters outside ASCII are kept as PostgreSQL lower-cases them.
+-- Only needed for the backfill.
+CREATE OR REPLACE FUNCTION pg_temp.tag_slug(name TEXT) RETURNS TEXT AS $$
+    SELECT trim(both '-' FROM regexp_replace(
+        regexp_replace(
+            replace(
+                regexp_replace(regexp_replace(regexp_replace(lower(trim(name)),
+                    '([[:alnum:]])\+\+', '\1pp', 'g'),
+                    '([[:alnum:]])#', '\1-sharp-', 'g'),
+                    '([[:alnum:]])\+', '\1-plus-', 'g'),
+                ' ', '-'),
+            '[\x01-\x2c\x2e\x2f\x3a-\x40\x5b-\x5e\x60\x7b-\x7f]+', '', 'g'),
+        '-+', '-', 'g'))
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Names without a slug ("!!!") and different names that share a slug are
+-- reported, and the backfill stops so they can be renamed first; nothing
+-- is dropped. Spellings of one name ("Go", "go ") simply share a tag.
+DO $$
+DECLARE
+    problem RECORD;
+    problems INTEGER := 0;
+BEGIN
+    FOR problem IN
+        SELECT DISTINCT name FROM article_tags WHERE pg_temp.tag_slug(name) = ''
+    LOOP
+        RAISE WARNING 'tag "%" has no letters or digits', problem.name;
+        problems := problems + 1;
+    END LOOP;
+
+    FOR problem IN
+        SELECT pg_temp.tag_slug(name) AS slug, string_agg(DISTINCT name, '", "') AS names
+        FROM article_tags
+        WHERE pg_temp.tag_slug(name) <> ''
+        GROUP BY pg_temp.tag_slug(name)
+        HAVING COUNT(DISTINCT lower(trim(name))) > 1
+    LOOP
+        RAISE WARNING 'tags "%" would all become slug "%"', problem.names, problem.slug;
+        problems := problems + 1;
+    END LOOP;
+
+    IF problems > 0 THEN
+        RAISE EXCEPTION '% tag collision(s) found; rename the tags listed above and run the migration again', problems;
+    END IF;
+END
+$$;
+
+-- One tag per slug, named after its most used spelling
+INSERT INTO tags (name, slug)
+SELECT DISTINCT ON (slug) name, slug
+FROM (
+    SELECT name, pg_temp.tag_slug(name) AS slug, COUNT(*) AS uses
+    FROM article_tags
+    GROUP BY name
+) spellings
+ORDER BY slug, uses DESC, name
+ON CONFLICT (slug) DO NOTHING;
+
+-- An article tagged with two spellings of one name keeps a single link
+DELETE FROM article_tags a
+USING article_tags b
+WHERE a.article_id = b.article_id
+  AND a.id > b.id
+  AND lower(trim(a.name)) = lower(trim(b.name));
+
+UPDATE article_tags at
+SET tag_id = t.id, name = t.name
+FROM tags t
+WHERE t.slug = pg_temp.tag_slug(at.name);
+
+ALTER TABLE article_tags ALTER COLUMN tag_id SET NOT NULL;
+ALTER TABLE article_tags ADD CONSTRAINT article_tags_article_id_tag_id_key UNIQUE (article_id, tag_id);
+CREATE INDEX IF NOT EXISTS idx_article_tags_tag_id ON article_tags(tag_id);
@@ -0,0 +1,19 @@
+-- Multi-part article series
+CREATE TABLE IF NOT EXISTS series (
+    id VARCHAR(255) PRIMARY KEY,
+    title VARCHAR(500) NOT NULL,
+    slug VARCHAR(500) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- An article belongs to at most one series
+CREATE TABLE IF NOT EXISTS series_articles (
+    series_id VARCHAR(255) NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+    article_id VARCHAR(255) NOT NULL UNIQUE REFERENCES articles(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (series_id, article_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_articles_position ON series_articles(series_id, position);
@@ -0,0 +1,19 @@
+-- Threaded customer comments with a moderation queue
+CREATE TABLE IF NOT EXISTS article_comments (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    parent_id VARCHAR(255) REFERENCES article_comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    spam_score INTEGER NOT NULL DEFAULT 0,
+    spam_reasons TEXT[] NOT NULL DEFAULT '{}',
+    moderated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_comments_article ON article_comments(article_id, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_article_comments_queue ON article_comments(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_article_comments_user ON article_comments(user_id, created_at DESC);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS article_likes;
+DROP TABLE IF EXISTS article_views;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS legacy_like_count;
+ALTER TABLE articles DROP COLUMN IF EXISTS legacy_view_count;
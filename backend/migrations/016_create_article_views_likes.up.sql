@@ -0,0 +1,25 @@
+-- Per-visitor views and likes; articles.view_count and like_count are derived
+-- from these plus the totals counted before visitors were tracked.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS legacy_view_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS legacy_like_count INTEGER NOT NULL DEFAULT 0;
+UPDATE articles SET legacy_view_count = view_count, legacy_like_count = like_count;
+
+-- visitor_key is "user:<id>" for customers or "anon:<salted hash>" for anonymous visitors
+CREATE TABLE IF NOT EXISTS article_views (
+    id BIGSERIAL PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    visitor_key VARCHAR(128) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    viewed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_views_visitor ON article_views(article_id, visitor_key, viewed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_article_views_viewed_at ON article_views(viewed_at);
+
+CREATE TABLE IF NOT EXISTS article_likes (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    visitor_key VARCHAR(128) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, visitor_key)
+);
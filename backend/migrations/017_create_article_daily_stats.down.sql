@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS article_daily_referrers;
+DROP TABLE IF EXISTS article_daily_stats;
+
+ALTER TABLE article_views DROP COLUMN IF EXISTS referrer_domain;
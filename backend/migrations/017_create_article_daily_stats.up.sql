@@ -0,0 +1,42 @@
+-- Daily per-article rollups for the analytics dashboard, kept up to date as
+-- views and likes are recorded
+ALTER TABLE article_views ADD COLUMN IF NOT EXISTS referrer_domain VARCHAR(255) NOT NULL DEFAULT 'direct';
+
+CREATE TABLE IF NOT EXISTS article_daily_stats (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views INTEGER NOT NULL DEFAULT 0,
+    likes INTEGER NOT NULL DEFAULT 0, -- net of unlikes
+    PRIMARY KEY (article_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_daily_stats_day ON article_daily_stats(day);
+
+CREATE TABLE IF NOT EXISTS article_daily_referrers (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    domain VARCHAR(255) NOT NULL,
+    views INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (article_id, day, domain)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_daily_referrers_day ON article_daily_referrers(day);
+
+-- Backfill from the views and likes tracked so far
+INSERT INTO article_daily_stats (article_id, day, views, likes)
+SELECT article_id, day, SUM(views), SUM(likes)
+FROM (
+    SELECT article_id, viewed_at::date AS day, COUNT(*) AS views, 0 AS likes
+    FROM article_views GROUP BY article_id, viewed_at::date
+    UNION ALL
+    SELECT article_id, created_at::date AS day, 0 AS views, COUNT(*) AS likes
+    FROM article_likes GROUP BY article_id, created_at::date
+) events
+GROUP BY article_id, day
+ON CONFLICT (article_id, day) DO NOTHING;
+
+INSERT INTO article_daily_referrers (article_id, day, domain, views)
+SELECT article_id, viewed_at::date, referrer_domain, COUNT(*)
+FROM article_views
+GROUP BY article_id, viewed_at::date, referrer_domain
+ON CONFLICT (article_id, day, domain) DO NOTHING;
@@ -0,0 +1,13 @@
+-- Admin overrides for generated SEO metadata; content_id is the id of the
+-- article, project or course the override applies to
+CREATE TABLE IF NOT EXISTS seo_overrides (
+    content_type VARCHAR(20) NOT NULL CHECK (content_type IN ('article', 'project', 'course')),
+    content_id VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    image TEXT NOT NULL DEFAULT '',
+    canonical_url TEXT NOT NULL DEFAULT '',
+    no_index BOOLEAN NOT NULL DEFAULT false,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (content_type, content_id)
+);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS courses_slug_redirect_cleanup ON courses;
+DROP TRIGGER IF EXISTS courses_slug_redirect ON courses;
+DROP TRIGGER IF EXISTS projects_slug_redirect_cleanup ON projects;
+DROP TRIGGER IF EXISTS projects_slug_redirect ON projects;
+DROP TRIGGER IF EXISTS articles_slug_redirect_cleanup ON articles;
+DROP TRIGGER IF EXISTS articles_slug_redirect ON articles;
+DROP FUNCTION IF EXISTS delete_slug_redirects();
+DROP FUNCTION IF EXISTS record_slug_redirect();
+DROP TABLE IF EXISTS slug_redirects;
@@ -0,0 +1,73 @@
+-- Old slugs of articles, projects and courses, so links to a renamed item
+-- keep working; content_id is the id of the item the slug now redirects to
+CREATE TABLE IF NOT EXISTS slug_redirects (
+    content_type VARCHAR(20) NOT NULL CHECK (content_type IN ('article', 'project', 'course')),
+    old_slug VARCHAR(255) NOT NULL,
+    content_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (content_type, old_slug)
+);
+
+CREATE INDEX IF NOT EXISTS idx_slug_redirects_content ON slug_redirects(content_type, content_id);
+
+-- Records the previous slug whenever it changes. A slug taken by a live
+-- item never redirects, so renaming back to an old slug drops its redirect.
+CREATE OR REPLACE FUNCTION record_slug_redirect()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM slug_redirects WHERE content_type = TG_ARGV[0] AND old_slug = NEW.slug;
+
+    IF TG_OP = 'UPDATE' AND OLD.slug IS DISTINCT FROM NEW.slug THEN
+        INSERT INTO slug_redirects (content_type, old_slug, content_id)
+        VALUES (TG_ARGV[0], OLD.slug, NEW.id::text)
+        ON CONFLICT (content_type, old_slug)
+        DO UPDATE SET content_id = EXCLUDED.content_id, created_at = CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION delete_slug_redirects()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM slug_redirects WHERE content_type = TG_ARGV[0] AND content_id = OLD.id::text;
+    RETURN OLD;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS articles_slug_redirect ON articles;
+CREATE TRIGGER articles_slug_redirect
+    AFTER INSERT OR UPDATE OF slug ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION record_slug_redirect('article');
+
+DROP TRIGGER IF EXISTS articles_slug_redirect_cleanup ON articles;
+CREATE TRIGGER articles_slug_redirect_cleanup
+    AFTER DELETE ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_slug_redirects('article');
+
+DROP TRIGGER IF EXISTS projects_slug_redirect ON projects;
+CREATE TRIGGER projects_slug_redirect
+    AFTER INSERT OR UPDATE OF slug ON projects
+    FOR EACH ROW
+    EXECUTE FUNCTION record_slug_redirect('project');
+
+DROP TRIGGER IF EXISTS projects_slug_redirect_cleanup ON projects;
+CREATE TRIGGER projects_slug_redirect_cleanup
+    AFTER DELETE ON projects
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_slug_redirects('project');
+
+DROP TRIGGER IF EXISTS courses_slug_redirect ON courses;
+CREATE TRIGGER courses_slug_redirect
+    AFTER INSERT OR UPDATE OF slug ON courses
+    FOR EACH ROW
+    EXECUTE FUNCTION record_slug_redirect('course');
+
+DROP TRIGGER IF EXISTS courses_slug_redirect_cleanup ON courses;
+CREATE TRIGGER courses_slug_redirect_cleanup
+    AFTER DELETE ON courses
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_slug_redirects('course');
@@ -0,0 +1,15 @@
+-- Expiring, revocable links that show an unpublished article or course to
+-- reviewers without an admin account. Only the SHA-256 of the token is
+-- stored; the token itself is shown once, when it is created.
+CREATE TABLE IF NOT EXISTS preview_tokens (
+    id VARCHAR(255) PRIMARY KEY,
+    content_type VARCHAR(20) NOT NULL CHECK (content_type IN ('article', 'course')),
+    content_id VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_preview_tokens_content ON preview_tokens(content_type, content_id);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS articles_author_profile ON articles;
+DROP FUNCTION IF EXISTS ensure_author_profile();
+DROP FUNCTION IF EXISTS author_profile_slug(TEXT);
+DROP TABLE IF EXISTS author_profiles;
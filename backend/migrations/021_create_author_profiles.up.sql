@@ -0,0 +1,64 @@
+-- Public profile of a user who writes articles; the page lives at
+-- /authors/:slug. social_links maps a network name to a profile URL.
+CREATE TABLE IF NOT EXISTS author_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    bio TEXT NOT NULL DEFAULT '',
+    avatar TEXT NOT NULL DEFAULT '',
+    social_links JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- First free slug for a name: "jane-doe", then "jane-doe-2", ...
+CREATE OR REPLACE FUNCTION author_profile_slug(author_name TEXT)
+RETURNS TEXT AS $$
+DECLARE
+    base TEXT;
+    candidate TEXT;
+    n INTEGER := 1;
+BEGIN
+    base := COALESCE(NULLIF(TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(author_name, '[^a-zA-Z0-9]+', '-', 'g'))), ''), 'author');
+    candidate := base;
+    WHILE EXISTS (SELECT 1 FROM author_profiles WHERE slug = candidate) LOOP
+        n := n + 1;
+        candidate := base || '-' || n;
+    END LOOP;
+    RETURN candidate;
+END;
+$$ language 'plpgsql';
+
+-- Every article author gets a profile, so their name can always link to a page
+CREATE OR REPLACE FUNCTION ensure_author_profile()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM author_profiles WHERE user_id = NEW.author_id) THEN
+        INSERT INTO author_profiles (user_id, slug)
+        SELECT id, author_profile_slug(name) FROM users WHERE id = NEW.author_id
+        ON CONFLICT DO NOTHING;
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS articles_author_profile ON articles;
+CREATE TRIGGER articles_author_profile
+    AFTER INSERT OR UPDATE OF author_id ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION ensure_author_profile();
+
+-- Profiles for admins and existing authors, oldest first so they keep the plain slug
+DO $$
+DECLARE
+    u RECORD;
+BEGIN
+    FOR u IN
+        SELECT id, name FROM users
+        WHERE user_type = 'admin' OR id IN (SELECT author_id FROM articles)
+        ORDER BY created_at, id
+    LOOP
+        INSERT INTO author_profiles (user_id, slug)
+        VALUES (u.id, author_profile_slug(u.name))
+        ON CONFLICT DO NOTHING;
+    END LOOP;
+END $$;
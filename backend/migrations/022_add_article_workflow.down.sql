@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS article_transitions;
+DROP INDEX IF EXISTS idx_articles_status;
+ALTER TABLE articles DROP COLUMN IF EXISTS status;
@@ -0,0 +1,27 @@
+-- Editorial workflow: the status replaces the published flag as the source
+-- of truth; published is kept in step with it for visibility queries
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'draft'
+    CHECK (status IN ('draft', 'in_review', 'changes_requested', 'approved', 'published', 'archived'));
+
+-- Scheduled articles were already signed off, so they start out approved
+UPDATE articles SET status = CASE
+    WHEN published THEN 'published'
+    WHEN publish_at IS NOT NULL THEN 'approved'
+    ELSE 'draft'
+END;
+
+CREATE INDEX IF NOT EXISTS idx_articles_status ON articles(status);
+
+-- One row per workflow step, with the reviewer's comment
+CREATE TABLE IF NOT EXISTS article_transitions (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    action VARCHAR(30) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_transitions_article_id ON article_transitions(article_id, created_at);
@@ -0,0 +1,18 @@
+-- Translated variants of an article. The article row stays the source
+-- language; each translation has its own slug and publish state.
+CREATE TABLE IF NOT EXISTS article_translations (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    excerpt TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    read_time INTEGER NOT NULL DEFAULT 0,
+    slug VARCHAR(500) NOT NULL UNIQUE,
+    published BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (article_id, language)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_translations_language ON article_translations(language, published);
@@ -0,0 +1,19 @@
+-- Named places on the site (hero, sidebar, homepage grid) filled with an
+-- ordered list of articles, projects or courses. content_id is the id of the
+-- item; starts_at and ends_at optionally limit when an entry is shown.
+CREATE TABLE IF NOT EXISTS featured_items (
+    id VARCHAR(255) PRIMARY KEY,
+    slot VARCHAR(50) NOT NULL CHECK (slot IN ('hero', 'sidebar', 'homepage_grid')),
+    content_type VARCHAR(20) NOT NULL CHECK (content_type IN ('article', 'project', 'course')),
+    content_id VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (slot, content_type, content_id),
+    CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_featured_items_slot ON featured_items(slot, position);
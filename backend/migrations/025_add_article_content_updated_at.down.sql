@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS update_articles_updated_at ON articles;
+CREATE TRIGGER update_articles_updated_at
+    BEFORE UPDATE ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+DROP FUNCTION IF EXISTS update_article_updated_at();
+
+ALTER TABLE articles DROP COLUMN IF EXISTS content_updated_at;
@@ -0,0 +1,29 @@
+-- View and like counters are written on every read, so they must not move
+-- updated_at. content_updated_at is when the text itself (title, excerpt,
+-- content, thumbnail) last changed; feeds, sitemaps and SEO metadata
+-- publish it as the modification time.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_updated_at TIMESTAMP;
+UPDATE articles SET content_updated_at = COALESCE(updated_at, published_at, CURRENT_TIMESTAMP)
+WHERE content_updated_at IS NULL;
+ALTER TABLE articles
+    ALTER COLUMN content_updated_at SET NOT NULL,
+    ALTER COLUMN content_updated_at SET DEFAULT CURRENT_TIMESTAMP;
+
+CREATE OR REPLACE FUNCTION update_article_updated_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (to_jsonb(NEW) - 'view_count' - 'like_count' - 'updated_at')
+        IS DISTINCT FROM (to_jsonb(OLD) - 'view_count' - 'like_count' - 'updated_at') THEN
+        NEW.updated_at = CURRENT_TIMESTAMP;
+    ELSE
+        NEW.updated_at = OLD.updated_at;
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS update_articles_updated_at ON articles;
+CREATE TRIGGER update_articles_updated_at
+    BEFORE UPDATE ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_article_updated_at();
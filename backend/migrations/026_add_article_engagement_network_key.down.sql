@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_article_likes_network;
+DROP INDEX IF EXISTS idx_article_views_network;
+
+ALTER TABLE article_likes DROP COLUMN IF EXISTS network_key;
+ALTER TABLE article_views DROP COLUMN IF EXISTS network_key;
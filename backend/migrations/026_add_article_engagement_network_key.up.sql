@@ -0,0 +1,9 @@
+-- network_key is a salted hash of the IP address and user agent of an
+-- anonymous visitor. It caps how many visitor IDs one address counts as.
+ALTER TABLE article_views ADD COLUMN IF NOT EXISTS network_key VARCHAR(128);
+ALTER TABLE article_likes ADD COLUMN IF NOT EXISTS network_key VARCHAR(128);
+
+CREATE INDEX IF NOT EXISTS idx_article_views_network ON article_views(article_id, network_key, viewed_at DESC)
+    WHERE network_key IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_article_likes_network ON article_likes(article_id, network_key)
+    WHERE network_key IS NOT NULL;
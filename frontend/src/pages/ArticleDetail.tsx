@@ -203,6 +203,12 @@ const ArticleDetail: React.FC = () => {
       try {
         setLoading(true);
         const data = await articleApi.getArticle(slug);
+        // Old slugs are redirected by the API; keep the address bar current.
+        // Another language version of the same article keeps its own URL.
+        const isLanguageVersion = data.translations?.some((t) => t.slug === slug);
+        if (data.slug && data.slug !== slug && !isLanguageVersion) {
+          navigate(`/articles/${data.slug}`, { replace: true });
+        }
         setArticle(data);
         setError(null);
       } catch (err: any) {
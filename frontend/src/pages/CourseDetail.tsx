@@ -1,10 +1,11 @@
 import React, { useState, useEffect } from 'react';
-import { useParams } from 'react-router-dom';
+import { useParams, useNavigate } from 'react-router-dom';
 import { BookOpen, Clock, BarChart, CheckCircle, Lock, Play, ChevronDown, ChevronUp } from 'lucide-react';
 import * as courseApi from '../api/courseApi';
 
 const CourseDetail: React.FC = () => {
   const { slug } = useParams<{ slug: string }>();
+  const navigate = useNavigate();
   const [course, setCourse] = useState<courseApi.Course | null>(null);
   const [loading, setLoading] = useState(true);
   const [selectedLesson, setSelectedLesson] = useState<courseApi.Lesson | null>(null);
@@ -21,6 +22,10 @@ const CourseDetail: React.FC = () => {
     try {
       setLoading(true);
       const courseData = await courseApi.getCourseBySlug(slug!);
+      // Old slugs are redirected by the API; keep the address bar current
+      if (courseData.slug && courseData.slug !== slug) {
+        navigate(`/courses/${courseData.slug}`, { replace: true });
+      }
       setCourse(courseData);
       setIsEnrolled(courseData.is_enrolled);
 
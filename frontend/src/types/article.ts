@@ -25,6 +25,15 @@ export interface Article {
   tags?: string[];
   viewCount?: number;
   likeCount?: number;
+  language?: string;
+  sourceLanguage?: string;
+  translations?: ArticleTranslationLink[];
+}
+
+export interface ArticleTranslationLink {
+  language: string;
+  slug: string;
+  source?: boolean;
 }
 
 export interface ArticleCategory {
@@ -17,6 +17,9 @@ export default defineConfig({
         target: 'http://localhost:8080',
         changeOrigin: true,
       },
+      '/robots.txt': 'http://localhost:8080',
+      '/sitemap.xml': 'http://localhost:8080',
+      '/sitemaps': 'http://localhost:8080',
     },
   },
   build: {